@@ -0,0 +1,10 @@
+// Package docs embeds the OpenAPI document generated from the protos.
+package docs
+
+import _ "embed"
+
+// OpenAPI is the Swagger 2.0 document describing every route of the HTTP/JSON
+// gateway.
+//
+//go:embed booking.swagger.json
+var OpenAPI []byte
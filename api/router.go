@@ -0,0 +1,100 @@
+// Package api serves the gRPC services over HTTP/JSON. Routes come from the
+// google.api.http annotations in protos/ and are documented in api/docs.
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Athlevo/Booking-Athlevo/api/docs"
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// forwardedHeaders are passed to the gRPC server as metadata of the same
+// name, so a call through the gateway is attributed, correlated and traced
+// exactly like a direct gRPC call.
+var forwardedHeaders = map[string]bool{
+	audit.ActorKey:     true,
+	audit.RequestIDKey: true,
+	"authorization":    true,
+	"traceparent":      true,
+	"tracestate":       true,
+	"baggage":          true,
+}
+
+// registrations register the gateway handlers of every gRPC service.
+var registrations = []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+	booking.RegisterBookingPersonalServiceHandler,
+	booking.RegisterBookingGroupServiceHandler,
+	booking.RegisterBookingCoachServiceHandler,
+	booking.RegisterBookingMemberServiceHandler,
+	booking.RegisterBookingTransferServiceHandler,
+	booking.RegisterSubscriptionPersonalServiceHandler,
+	booking.RegisterSubscriptionGroupServiceHandler,
+	booking.RegisterSubscriptionCoachServiceHandler,
+	booking.RegisterBundleServiceHandler,
+	booking.RegisterAccessServiceHandler,
+	booking.RegisterAccessServiceBetaHandler,
+	booking.RegisterGenderOverrideServiceHandler,
+	booking.RegisterOccupancyServiceHandler,
+	booking.RegisterOfflineAccessServiceHandler,
+	booking.RegisterAuditServiceHandler,
+	booking.RegisterWebhookServiceHandler,
+	booking.RegisterPassServiceHandler,
+}
+
+// NewRouter returns a handler that translates HTTP/JSON requests into calls
+// on conn, and serves the OpenAPI document at /openapi.json. Calls go through
+// the server's interceptors like any other client, and errors are written
+// with the HTTP status matching their gRPC code.
+func NewRouter(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	gateway := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		// Field names match the proto files and the OpenAPI document
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	for _, register := range registrations {
+		if err := register(ctx, gateway, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", serveOpenAPI)
+	mux.Handle("/", gateway)
+	return mux, nil
+}
+
+// incomingHeader forwards the identity, request ID and trace headers under
+// their gRPC metadata keys, and everything else as the gateway does by
+// default.
+func incomingHeader(key string) (string, bool) {
+	if name := strings.ToLower(key); forwardedHeaders[name] {
+		return name, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader returns the request ID the server echoes back as
+// X-Request-Id, and other response metadata with the gateway's default
+// prefix.
+func outgoingHeader(key string) (string, bool) {
+	if key == audit.RequestIDKey {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(docs.OpenAPI)
+}
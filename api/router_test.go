@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// occupancyServer answers for one gym and records who set its limit.
+type occupancyServer struct {
+	booking.UnimplementedOccupancyServiceServer
+	actor string
+}
+
+func (s *occupancyServer) GetOccupancy(ctx context.Context, req *booking.GetOccupancyRequest) (*booking.Occupancy, error) {
+	if req.GymId != "gym-1" {
+		return nil, status.Errorf(codes.NotFound, "gym %s not found", req.GymId)
+	}
+	return &booking.Occupancy{GymId: req.GymId, Current: 3}, nil
+}
+
+func (s *occupancyServer) SetMaxOccupancy(ctx context.Context, req *booking.SetMaxOccupancyRequest) (*booking.Occupancy, error) {
+	s.actor = audit.FromContext(ctx).Actor
+	return &booking.Occupancy{GymId: req.GymId, MaxOccupancy: req.MaxOccupancy}, nil
+}
+
+// newTestRouter serves occupancy over an in-memory gRPC server with the audit
+// interceptor, and returns a router calling it.
+func newTestRouter(t *testing.T, server *occupancyServer) http.Handler {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(grpc.UnaryInterceptor(audit.UnaryServerInterceptor))
+	booking.RegisterOccupancyServiceServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	router, err := NewRouter(context.Background(), conn)
+	assert.NoError(t, err)
+	return router
+}
+
+func TestRouter(t *testing.T) {
+	t.Run("TranslatesRequest", func(t *testing.T) {
+		router := newTestRouter(t, &occupancyServer{})
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/gyms/gym-1/occupancy", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "gym-1", body["gym_id"])
+		assert.Equal(t, float64(3), body["current"])
+		assert.NotEmpty(t, rec.Header().Get("X-Request-Id"))
+	})
+
+	t.Run("ForwardsActorAndRequestID", func(t *testing.T) {
+		server := &occupancyServer{}
+		router := newTestRouter(t, server)
+
+		req := httptest.NewRequest(http.MethodPut, "/v1/gyms/gym-1/max-occupancy", strings.NewReader(`{"max_occupancy": 40}`))
+		req.Header.Set("X-Actor-Id", "staff-1")
+		req.Header.Set("X-Request-Id", "request-1")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "staff-1", server.actor)
+		assert.Equal(t, "request-1", rec.Header().Get("X-Request-Id"))
+	})
+
+	t.Run("MapsErrorCodes", func(t *testing.T) {
+		router := newTestRouter(t, &occupancyServer{})
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/gyms/gym-2/occupancy", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, float64(codes.NotFound), body["code"])
+		assert.Equal(t, "gym gym-2 not found", body["message"])
+
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/gyms/gym-1/occupancy/unknown", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("ServesOpenAPI", func(t *testing.T) {
+		router := newTestRouter(t, &occupancyServer{})
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var doc struct {
+			Paths map[string]any `json:"paths"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		assert.Contains(t, doc.Paths, "/v1/gyms/{gym_id}/occupancy")
+		assert.Contains(t, doc.Paths, "/v1/bookings/personal/{id}")
+	})
+}
@@ -0,0 +1,86 @@
+// Package audit carries who made a request, and through which RPC, down to
+// the storage layer so changes can be attributed in the audit log.
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys read from incoming requests. The request ID is echoed back in
+// the response header, and generated when the caller sends none.
+const (
+	ActorKey     = "x-actor-id"
+	RequestIDKey = "x-request-id"
+)
+
+// Metadata describes the request a change was made in.
+type Metadata struct {
+	Actor     string // the user or staff member making the request
+	RPC       string // the full gRPC method name
+	RequestID string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying md.
+func NewContext(ctx context.Context, md Metadata) context.Context {
+	return context.WithValue(ctx, contextKey{}, md)
+}
+
+// FromContext returns the request metadata stored in ctx, or the zero value
+// for changes made outside a request.
+func FromContext(ctx context.Context) Metadata {
+	md, _ := ctx.Value(contextKey{}).(Metadata)
+	return md
+}
+
+// UnaryServerInterceptor stores the actor, RPC name and request ID of each
+// call in its context.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md := fromIncoming(ctx, info.FullMethod)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, md.RequestID))
+
+	return handler(NewContext(ctx, md), req)
+}
+
+// StreamServerInterceptor stores the actor, RPC name and request ID of each
+// stream in its context.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	md := fromIncoming(ss.Context(), info.FullMethod)
+	_ = ss.SetHeader(metadata.Pairs(RequestIDKey, md.RequestID))
+
+	return handler(srv, &serverStream{ServerStream: ss, ctx: NewContext(ss.Context(), md)})
+}
+
+// fromIncoming reads the request metadata sent by the caller of method,
+// generating a request ID when there is none.
+func fromIncoming(ctx context.Context, method string) Metadata {
+	md := Metadata{RPC: method}
+
+	if incoming, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := incoming.Get(ActorKey); len(values) > 0 {
+			md.Actor = values[0]
+		}
+		if values := incoming.Get(RequestIDKey); len(values) > 0 {
+			md.RequestID = values[0]
+		}
+	}
+	if md.RequestID == "" {
+		md.RequestID = uuid.New().String()
+	}
+	return md
+}
+
+// serverStream overrides the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,26 @@
+package checkin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// Sign returns the base64url HMAC-SHA256 of payload, for data such as offline
+// access lists that controllers verify with a shared secret.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is Sign(secret, payload).
+func VerifySignature(secret, payload []byte, signature string) bool {
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hmac.Equal(sig, mac.Sum(nil))
+}
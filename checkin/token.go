@@ -0,0 +1,115 @@
+// Package checkin issues and verifies the short-lived signed tokens members
+// show as a QR code at the turnstile.
+package checkin
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verification errors.
+var (
+	ErrMalformed = errors.New("malformed check-in token")
+	ErrSignature = errors.New("invalid check-in token signature")
+	ErrExpired   = errors.New("check-in token expired")
+)
+
+// Claims identify who a token was issued to and when it stops being valid.
+type Claims struct {
+	UserID    string
+	GymID     string
+	ExpiresAt time.Time
+}
+
+// Signer issues tokens for a rotating time step, TOTP style: a token is bound
+// to the step it was issued in and is accepted during that step and the one
+// after, so a QR code that rotates just before a scan still works.
+type Signer struct {
+	secret []byte
+	period time.Duration
+}
+
+// NewSigner creates a Signer that signs with secret and rotates every period.
+func NewSigner(secret []byte, period time.Duration) *Signer {
+	return &Signer{
+		secret: secret,
+		period: period,
+	}
+}
+
+// Issue returns a token for the user at the gym and the time it expires.
+// Every token carries a random nonce, so a member asking again within the
+// same step gets a fresh token rather than one that may have been used.
+func (s *Signer) Issue(userID, gymID string, now time.Time) (string, time.Time) {
+	step := s.step(now)
+	nonce := make([]byte, 12)
+	_, _ = rand.Read(nonce)
+	payload := strings.Join([]string{userID, gymID, strconv.FormatInt(step, 10), base64.RawURLEncoding.EncodeToString(nonce)}, "|")
+
+	token := base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(s.sign(payload))
+
+	return token, s.expiry(step)
+}
+
+// Verify checks the token's signature and expiry and returns its claims.
+func (s *Signer) Verify(token string, now time.Time) (*Claims, error) {
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrMalformed
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	if !hmac.Equal(sig, s.sign(string(payload))) {
+		return nil, ErrSignature
+	}
+
+	parts := strings.Split(string(payload), "|")
+	if len(parts) != 4 {
+		return nil, ErrMalformed
+	}
+	step, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	claims := &Claims{
+		UserID:    parts[0],
+		GymID:     parts[1],
+		ExpiresAt: s.expiry(step),
+	}
+	if !now.Before(claims.ExpiresAt) || step > s.step(now) {
+		return nil, ErrExpired
+	}
+
+	return claims, nil
+}
+
+// step returns the rotation step that now falls in.
+func (s *Signer) step(now time.Time) int64 {
+	return now.UnixNano() / int64(s.period)
+}
+
+// expiry returns when tokens of a step stop being accepted: the end of the
+// following step.
+func (s *Signer) expiry(step int64) time.Time {
+	return time.Unix(0, (step+2)*int64(s.period))
+}
+
+func (s *Signer) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,69 @@
+package checkin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner(t *testing.T) {
+	signer := NewSigner([]byte("secret"), 30*time.Second)
+	now := time.Unix(1700000000, 0)
+
+	t.Run("IssueAndVerify", func(t *testing.T) {
+		token, expiresAt := signer.Issue("user", "gym", now)
+		assert.True(t, expiresAt.After(now.Add(30*time.Second)))
+
+		claims, err := signer.Verify(token, now.Add(20*time.Second))
+		assert.NoError(t, err)
+		assert.Equal(t, "user", claims.UserID)
+		assert.Equal(t, "gym", claims.GymID)
+		assert.Equal(t, expiresAt, claims.ExpiresAt)
+	})
+
+	t.Run("FreshTokenPerIssue", func(t *testing.T) {
+		// A retry within the same step must not return a token that may
+		// already have been redeemed
+		first, _ := signer.Issue("user", "gym", now)
+		second, _ := signer.Issue("user", "gym", now)
+		assert.NotEqual(t, first, second)
+
+		_, err := signer.Verify(second, now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		token, expiresAt := signer.Issue("user", "gym", now)
+
+		_, err := signer.Verify(token, expiresAt)
+		assert.ErrorIs(t, err, ErrExpired)
+	})
+
+	t.Run("IssuedInTheFuture", func(t *testing.T) {
+		token, _ := signer.Issue("user", "gym", now.Add(time.Minute))
+
+		_, err := signer.Verify(token, now)
+		assert.ErrorIs(t, err, ErrExpired)
+	})
+
+	t.Run("WrongSecret", func(t *testing.T) {
+		token, _ := NewSigner([]byte("other"), 30*time.Second).Issue("user", "gym", now)
+
+		_, err := signer.Verify(token, now)
+		assert.ErrorIs(t, err, ErrSignature)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := signer.Verify("not-a-token", now)
+		assert.ErrorIs(t, err, ErrMalformed)
+	})
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign([]byte("secret"), []byte("payload"))
+
+	assert.True(t, VerifySignature([]byte("secret"), []byte("payload"), signature))
+	assert.False(t, VerifySignature([]byte("secret"), []byte("changed"), signature))
+	assert.False(t, VerifySignature([]byte("other"), []byte("payload"), signature))
+}
@@ -1,49 +1,304 @@
 package main
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/Athlevo/Booking-Athlevo/api"
+	"github.com/Athlevo/Booking-Athlevo/audit"
 	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/events"
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	apphealth "github.com/Athlevo/Booking-Athlevo/health"
+	applog "github.com/Athlevo/Booking-Athlevo/logger"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/notify"
 	"github.com/Athlevo/Booking-Athlevo/service"
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/Athlevo/Booking-Athlevo/webhook"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 func main() {
 	cfg := config.Load()
 
-	// Initialize PostgreSQL storage
-	storage, err := postgres.NewPostgresStorage(cfg)
+	// Stop on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Log structured lines to stdout and the rotated file at LOG_PATH
+	logger, logFile, err := applog.New(applog.Options{
+		Level:      cfg.LogLevel,
+		Format:     cfg.LogFormat,
+		Path:       cfg.LOG_PATH,
+		MaxSizeMB:  cfg.LogMaxSizeMB,
+		MaxBackups: cfg.LogMaxBackups,
+		MaxAgeDays: cfg.LogMaxAgeDays,
+	})
+	if err != nil {
+		slog.Error("failed to initialize logger", "error", err)
+		os.Exit(1)
+	}
+	defer logFile.Close()
+	slog.SetDefault(logger)
+
+	// Export traces of every RPC down to its SQL statements
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Options{
+		Exporter:    cfg.TraceExporter,
+		File:        cfg.TraceFile,
+		Endpoint:    cfg.TraceEndpoint,
+		SampleRatio: cfg.TraceSampleRatio,
+	})
+	if err != nil {
+		fatal(logger, "failed to initialize tracing", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// Initialize PostgreSQL storage on a pool shared with the workers below
+	db, err := postgres.Connect(cfg)
 	if err != nil {
-		log.Fatalf("failed to initialize storage: %v", err)
+		fatal(logger, "failed to initialize storage", err)
 	}
+	metrics.Registry.MustRegister(metrics.NewPoolCollector(db))
+	storage := postgres.NewPostgresStorage(db, cfg, logger)
+
+	// Background workers run until shutdown, each finishing the batch under way
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	runWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	// Relay domain events from the outbox
+	publisher, err := events.NewPublisher(cfg.EventPublisher, cfg.EventFile)
+	if err != nil {
+		fatal(logger, "failed to initialize event publisher", err)
+	}
+	defer publisher.Close()
+
+	relay := events.NewRelay(postgres.NewOutboxRepo(db, cfg.OutboxRetention, logger), publisher, cfg.OutboxPollInterval, logger)
+	runWorker(relay.Run)
+
+	// Send webhook deliveries
+	dispatcher := webhook.NewDispatcher(postgres.NewWebhookRepo(db, cfg, logger), webhook.NewClient(cfg.WebhookTimeout, cfg.WebhookAllowPrivate), cfg.WebhookMaxAttempts, cfg.WebhookRetryBase, logger)
+	runWorker(func(ctx context.Context) { dispatcher.Run(ctx, cfg.WebhookPollInterval) })
+
+	// Remind members about their plans and classes
+	scheduler := notify.NewScheduler(postgres.NewNotificationRepo(db, logger), notify.NewLogChannel(logger), notify.Rules{
+		ExpiryNotice: cfg.NotifyExpiryNotice,
+		VisitsLeft:   cfg.NotifyVisitsLeft,
+		ClassNotice:  cfg.NotifyClassNotice,
+	}, cfg.NotifyMaxAttempts, cfg.NotifyRetryInterval, logger)
+	runWorker(func(ctx context.Context) { scheduler.Run(ctx, cfg.NotifyInterval) })
 
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		fatal(logger, "failed to listen", err)
+	}
+
+	// Serve Prometheus metrics over HTTP
+	var metricsServer *http.Server
+	if cfg.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsServer = &http.Server{Addr: cfg.MetricsAddr, Handler: mux}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fatal(logger, "failed to serve metrics", err)
+			}
+		}()
 	}
 
-	s := grpc.NewServer()
+	// Trace, measure and log every RPC and attribute every change to its
+	// caller in the audit log. Streams end when the server starts draining.
+	draining, endStreams := context.WithCancel(context.Background())
+	s := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, audit.UnaryServerInterceptor, applog.UnaryServerInterceptor(logger)),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor, audit.StreamServerInterceptor, applog.StreamServerInterceptor(logger), endOnDrain(draining)),
+	)
 
 	// Register booking services
-	booking.RegisterBookingPersonalServiceServer(s, service.NewBookingPersonalService(storage))
-	booking.RegisterBookingGroupServiceServer(s, service.NewBookingGroupService(storage))
-	booking.RegisterBookingCoachServiceServer(s, service.NewBookingCoachService(storage))
+	booking.RegisterBookingPersonalServiceServer(s, service.NewBookingPersonalService(storage, logger))
+	booking.RegisterBookingGroupServiceServer(s, service.NewBookingGroupService(storage, logger))
+	booking.RegisterBookingCoachServiceServer(s, service.NewBookingCoachService(storage, logger))
+	booking.RegisterBookingMemberServiceServer(s, service.NewBookingMemberService(storage, logger))
+	booking.RegisterBookingTransferServiceServer(s, service.NewBookingTransferService(storage, logger))
 
 	// Register subscription services
-	booking.RegisterSubscriptionPersonalServiceServer(s, service.NewSubscriptionPersonalService(storage))
-	booking.RegisterSubscriptionGroupServiceServer(s, service.NewSubscriptionGroupService(storage))
-	booking.RegisterSubscriptionCoachServiceServer(s, service.NewSubscriptionCoachService(storage))
+	booking.RegisterSubscriptionPersonalServiceServer(s, service.NewSubscriptionPersonalService(storage, logger))
+	booking.RegisterSubscriptionGroupServiceServer(s, service.NewSubscriptionGroupService(storage, logger))
+	booking.RegisterSubscriptionCoachServiceServer(s, service.NewSubscriptionCoachService(storage, logger))
+	booking.RegisterBundleServiceServer(s, service.NewBundleService(storage, logger))
 
 	// Register access service
-	booking.RegisterAccessServiceServer(s, service.NewAccessService(storage))
-	booking.RegisterAccessServiceBetaServer(s, service.NewAccessServiceBeta(storage))
-	fmt.Println("gRPC server listening on", cfg.GRPCPort)
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	booking.RegisterAccessServiceServer(s, service.NewAccessService(storage, logger))
+	booking.RegisterAccessServiceBetaServer(s, service.NewAccessServiceBeta(storage, logger))
+	booking.RegisterGenderOverrideServiceServer(s, service.NewGenderOverrideService(storage, logger))
+	booking.RegisterOccupancyServiceServer(s, service.NewOccupancyService(storage, logger))
+	booking.RegisterOfflineAccessServiceServer(s, service.NewOfflineAccessService(storage, logger))
+
+	// Register audit service
+	booking.RegisterAuditServiceServer(s, service.NewAuditService(storage, logger))
+
+	// Register webhook service
+	booking.RegisterWebhookServiceServer(s, service.NewWebhookService(storage, logger))
+
+	// Register pass service
+	booking.RegisterPassServiceServer(s, service.NewPassService(storage, logger))
+
+	// Report readiness from database connectivity
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	services := make([]string, 0, len(s.GetServiceInfo()))
+	for name := range s.GetServiceInfo() {
+		services = append(services, name)
 	}
+	checker := apphealth.NewChecker(healthServer, storage.Ping, services, cfg.HealthCheckInterval, logger)
+	checker.Check(ctx)
+	go checker.Run(workerCtx)
+
+	if cfg.GRPCReflection {
+		reflection.Register(s)
+	}
+
+	// Serve the same services over HTTP/JSON through a client of the gRPC
+	// server, so every call passes the interceptors above
+	var gatewayServer *http.Server
+	var gatewayConn *grpc.ClientConn
+	if cfg.GatewayAddr != "" {
+		gatewayConn, err = grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fatal(logger, "failed to connect gateway", err)
+		}
+		router, err := api.NewRouter(ctx, gatewayConn)
+		if err != nil {
+			fatal(logger, "failed to initialize gateway", err)
+		}
+		gatewayServer = &http.Server{Addr: cfg.GatewayAddr, Handler: router}
+		go func() {
+			logger.Info("HTTP gateway listening", "addr", cfg.GatewayAddr)
+			if err := gatewayServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fatal(logger, "failed to serve gateway", err)
+			}
+		}()
+	}
+
+	go func() {
+		logger.Info("gRPC server listening", "addr", cfg.GRPCPort)
+		if err := s.Serve(lis); err != nil {
+			fatal(logger, "failed to serve", err)
+		}
+	}()
+
+	// A second signal stops the process at once
+	<-ctx.Done()
+	stop()
+	logger.Info("shutting down")
+
+	// Stop taking new requests once load balancers have seen the server is
+	// not ready, then let in-flight RPCs finish
+	checker.Shutdown()
+	time.Sleep(cfg.ShutdownDrainDelay)
+	endStreams()
+
+	// The gateway finishes its requests first, while the gRPC server still
+	// answers them
+	deadline := time.Now().Add(cfg.ShutdownTimeout)
+	if gatewayServer != nil {
+		shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		_ = gatewayServer.Shutdown(shutdownCtx)
+		cancel()
+		_ = gatewayConn.Close()
+	}
+
+	if !wait(s.GracefulStop, time.Until(deadline)) {
+		logger.Warn("in-flight RPCs did not finish in time, cancelling them")
+		s.Stop()
+	}
+
+	if metricsServer != nil {
+		shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		_ = metricsServer.Shutdown(shutdownCtx)
+		cancel()
+	}
+
+	stopWorkers()
+	if !wait(workers.Wait, time.Until(deadline)) {
+		logger.Warn("background workers did not finish in time")
+	}
+
+	// Close the database connections last
+	closeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := storage.Close(closeCtx); err != nil {
+		logger.Error("failed to close storage", "error", err)
+	}
+	logger.Info("shutdown complete")
+}
+
+// endOnDrain ends streaming RPCs, which would otherwise never finish, once
+// draining is done. Clients reconnect to another instance.
+func endOnDrain(draining context.Context) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		stop := context.AfterFunc(draining, cancel)
+		defer stop()
+
+		return handler(srv, &drainingStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// drainingStream overrides the context of a stream.
+type drainingStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *drainingStream) Context() context.Context {
+	return s.ctx
+}
+
+// wait runs fn and reports whether it returned within timeout.
+func wait(fn func(), timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// fatal logs a startup failure and exits.
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, "error", err)
+	os.Exit(1)
 }
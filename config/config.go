@@ -1,8 +1,9 @@
 package config
 
 import (
-	"fmt"
+	"log/slog"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -12,12 +13,19 @@ import (
 type Config struct {
 	GRPCPort string
 
+	// Server Configuration
+	GRPCReflection      bool          // expose server reflection, for grpcurl and similar tools
+	HealthCheckInterval time.Duration // how often database connectivity is checked for readiness
+	ShutdownDrainDelay  time.Duration // how long readiness reports not serving before the server stops taking requests
+	ShutdownTimeout     time.Duration // how long in-flight RPCs and workers get to finish
+
 	// PostgreSQL Configuration (Development)
 	PostgresHost     string
 	PostgresPort     int
 	PostgresUser     string
 	PostgresPassword string
 	PostgresDB       string
+	PostgresMaxConns int32 // most connections the pool opens, shared by requests and workers
 
 	// PostgreSQL Configuration (Testing)
 	PostgresHostTest     string
@@ -48,25 +56,86 @@ type Config struct {
 	KafkaWearableDataTopic         string
 	KafkaHealthRecommendationTopic string
 
-	LOG_PATH string
+	// Logging Configuration
+	LOG_PATH      string // file logs are also written to; empty logs to stdout only
+	LogLevel      string // debug, info, warn or error
+	LogFormat     string // json or text
+	LogMaxSizeMB  int    // size at which the log file is rotated
+	LogMaxBackups int    // rotated log files kept
+	LogMaxAgeDays int    // days rotated log files are kept
+
+	// Turnstile Configuration
+	AccessReentryTimeout      time.Duration // how long an entry without an exit blocks re-entry
+	AccessDuplicateScanWindow time.Duration // repeated scans within this window count as one visit
+	AccessAuditRetention      time.Duration // how long denied access attempts are kept; zero keeps them forever
+
+	// Check-in Token Configuration
+	CheckInTokenSecret string        // HMAC key for QR check-in tokens; tokens are disabled when empty
+	CheckInTokenPeriod time.Duration // how often a member's check-in token rotates
+
+	// Face-ID Check-in Configuration
+	FaceMinConfidence float64 // matches reported below this confidence are rejected
+
+	// Offline Access Configuration
+	OfflineExportSecret string // HMAC key controllers use to verify offline access lists
+
+	// Event Publishing Configuration
+	EventPublisher     string        // "file" or "memory"
+	EventFile          string        // where the file publisher writes events
+	OutboxPollInterval time.Duration // how often the relay looks for new events
+	OutboxRetention    time.Duration // how long published events are kept; zero keeps them forever
+
+	// Webhook Configuration
+	WebhookTimeout      time.Duration // how long an endpoint has to answer
+	WebhookMaxAttempts  int32         // failed attempts before a delivery is dead
+	WebhookRetryBase    time.Duration // wait before the first retry; doubles with each one
+	WebhookPollInterval time.Duration // how often due deliveries are sent
+	WebhookAllowPrivate bool          // accept http and loopback, link-local and private endpoints, for tests and local development
+
+	// Notification Configuration
+	NotifyExpiryNotice  time.Duration // how long before a plan ends to remind its holder
+	NotifyVisitsLeft    int32         // remind when this many visits or fewer are left
+	NotifyClassNotice   time.Duration // how long before a group class to remind its members
+	NotifyInterval      time.Duration // how often reminders are scheduled and sent
+	NotifyMaxAttempts   int32         // failed sends before a reminder is given up
+	NotifyRetryInterval time.Duration // wait before sending a failed reminder again
+
+	// Metrics Configuration
+	MetricsAddr string // address of the Prometheus metrics endpoint; empty disables it
+
+	// Gateway Configuration
+	GatewayAddr string // address of the HTTP/JSON gateway; empty disables it
+
+	// Tracing Configuration
+	TraceExporter    string  // none, stdout, file or otlp
+	TraceFile        string  // where the file exporter writes spans
+	TraceEndpoint    string  // OTLP collector address
+	TraceSampleRatio float64 // share of new traces recorded
 }
 
 // Load loads the configuration from environment variables.
 func Load() Config {
 	if err := godotenv.Load(); err != nil {
-		fmt.Println("No .env file found")
+		slog.Info("no .env file found, using the environment")
 	}
 
 	config := Config{}
 
 	config.GRPCPort = cast.ToString(coalesce("GRPC_Port", ":8082"))
 
+	// Server
+	config.GRPCReflection = cast.ToBool(coalesce("GRPC_REFLECTION", false))
+	config.HealthCheckInterval = cast.ToDuration(coalesce("HEALTH_CHECK_INTERVAL", "5s"))
+	config.ShutdownDrainDelay = cast.ToDuration(coalesce("SHUTDOWN_DRAIN_DELAY", "5s"))
+	config.ShutdownTimeout = cast.ToDuration(coalesce("SHUTDOWN_TIMEOUT", "30s"))
+
 	// PostgreSQL Configuration (Development)
 	config.PostgresHost = cast.ToString(coalesce("POSTGRES_HOST", "postgres_dock"))
 	config.PostgresPort = cast.ToInt(coalesce("POSTGRES_PORT", 5432))
 	config.PostgresUser = cast.ToString(coalesce("POSTGRES_USER", "postgres"))
 	config.PostgresPassword = cast.ToString(coalesce("POSTGRES_PASSWORD", "root"))
 	config.PostgresDB = cast.ToString(coalesce("POSTGRES_DB", "booking"))
+	config.PostgresMaxConns = cast.ToInt32(coalesce("POSTGRES_MAX_CONNS", 10))
 
 	// PostgreSQL Configuration (Testing)
 	config.PostgresHostTest = cast.ToString(coalesce("POSTGRES_HOST_TEST", "localhost"))
@@ -96,7 +165,62 @@ func Load() Config {
 	config.KafkaLifestyleDataTopic = cast.ToString(coalesce("KAFKA_LIFESTYLE_DATA_TOPIC", "lifestyle_data_topic"))
 	config.KafkaWearableDataTopic = cast.ToString(coalesce("KAFKA_WEARABLE_DATA_TOPIC", "wearable_data_topic"))
 	config.KafkaHealthRecommendationTopic = cast.ToString(coalesce("KAFKA_HEALTH_RECOMMENDATION_TOPIC", "health_recommendation_topic"))
+
+	// Logging
 	config.LOG_PATH = cast.ToString(coalesce("LOG_PATH", "logs/info.log"))
+	config.LogLevel = cast.ToString(coalesce("LOG_LEVEL", "info"))
+	config.LogFormat = cast.ToString(coalesce("LOG_FORMAT", "json"))
+	config.LogMaxSizeMB = cast.ToInt(coalesce("LOG_MAX_SIZE_MB", 100))
+	config.LogMaxBackups = cast.ToInt(coalesce("LOG_MAX_BACKUPS", 5))
+	config.LogMaxAgeDays = cast.ToInt(coalesce("LOG_MAX_AGE_DAYS", 30))
+
+	// Turnstile
+	config.AccessReentryTimeout = cast.ToDuration(coalesce("ACCESS_REENTRY_TIMEOUT", "4h"))
+	config.AccessDuplicateScanWindow = cast.ToDuration(coalesce("ACCESS_DUPLICATE_SCAN_WINDOW", "1m"))
+	config.AccessAuditRetention = cast.ToDuration(coalesce("ACCESS_AUDIT_RETENTION", "2160h"))
+
+	// Check-in tokens
+	config.CheckInTokenSecret = cast.ToString(coalesce("CHECKIN_TOKEN_SECRET", ""))
+	config.CheckInTokenPeriod = cast.ToDuration(coalesce("CHECKIN_TOKEN_PERIOD", "30s"))
+
+	// Face-ID check-in
+	config.FaceMinConfidence = cast.ToFloat64(coalesce("FACE_MIN_CONFIDENCE", 0.9))
+
+	// Offline access
+	config.OfflineExportSecret = cast.ToString(coalesce("OFFLINE_EXPORT_SECRET", ""))
+
+	// Event publishing
+	config.EventPublisher = cast.ToString(coalesce("EVENT_PUBLISHER", "file"))
+	config.EventFile = cast.ToString(coalesce("EVENT_FILE", "logs/events.jsonl"))
+	config.OutboxPollInterval = cast.ToDuration(coalesce("OUTBOX_POLL_INTERVAL", "1s"))
+	config.OutboxRetention = cast.ToDuration(coalesce("OUTBOX_RETENTION", "168h"))
+
+	// Webhooks
+	config.WebhookTimeout = cast.ToDuration(coalesce("WEBHOOK_TIMEOUT", "10s"))
+	config.WebhookMaxAttempts = cast.ToInt32(coalesce("WEBHOOK_MAX_ATTEMPTS", 8))
+	config.WebhookRetryBase = cast.ToDuration(coalesce("WEBHOOK_RETRY_BASE", "30s"))
+	config.WebhookPollInterval = cast.ToDuration(coalesce("WEBHOOK_POLL_INTERVAL", "5s"))
+	config.WebhookAllowPrivate = cast.ToBool(coalesce("WEBHOOK_ALLOW_PRIVATE", false))
+
+	// Notifications
+	config.NotifyExpiryNotice = cast.ToDuration(coalesce("NOTIFY_EXPIRY_NOTICE", "72h"))
+	config.NotifyVisitsLeft = cast.ToInt32(coalesce("NOTIFY_VISITS_LEFT", 2))
+	config.NotifyClassNotice = cast.ToDuration(coalesce("NOTIFY_CLASS_NOTICE", "1h"))
+	config.NotifyInterval = cast.ToDuration(coalesce("NOTIFY_INTERVAL", "1m"))
+	config.NotifyMaxAttempts = cast.ToInt32(coalesce("NOTIFY_MAX_ATTEMPTS", 3))
+	config.NotifyRetryInterval = cast.ToDuration(coalesce("NOTIFY_RETRY_INTERVAL", "5m"))
+
+	// Metrics
+	config.MetricsAddr = cast.ToString(coalesce("METRICS_ADDR", ":9090"))
+
+	// Gateway
+	config.GatewayAddr = cast.ToString(coalesce("GATEWAY_ADDR", ":8080"))
+
+	// Tracing
+	config.TraceExporter = cast.ToString(coalesce("TRACE_EXPORTER", "none"))
+	config.TraceFile = cast.ToString(coalesce("TRACE_FILE", "logs/traces.jsonl"))
+	config.TraceEndpoint = cast.ToString(coalesce("TRACE_ENDPOINT", "localhost:4317"))
+	config.TraceSampleRatio = cast.ToFloat64(coalesce("TRACE_SAMPLE_RATIO", 1.0))
 
 	return config
 }
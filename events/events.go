@@ -0,0 +1,84 @@
+// Package events publishes domain events that other services react to. State
+// changes write their events to an outbox in the same transaction, and a
+// Relay delivers them through a Publisher.
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Event types. Each is published with the payload message of its version,
+// e.g. BookingCreatedV1 for TypeBookingCreated version 1.
+const (
+	TypeBookingCreated      = "booking.created"
+	TypeBookingCancelled    = "booking.cancelled"
+	TypeAccessGranted       = "access.granted"
+	TypeSubscriptionExpired = "subscription.expired"
+)
+
+// payloads maps each event type and version to its payload message.
+var payloads = map[string]map[int32]func() proto.Message{
+	TypeBookingCreated:      {1: func() proto.Message { return &booking.BookingCreatedV1{} }},
+	TypeBookingCancelled:    {1: func() proto.Message { return &booking.BookingCancelledV1{} }},
+	TypeAccessGranted:       {1: func() proto.Message { return &booking.AccessGrantedV1{} }},
+	TypeSubscriptionExpired: {1: func() proto.Message { return &booking.SubscriptionExpiredV1{} }},
+}
+
+// IsType reports whether eventType is a known event type.
+func IsType(eventType string) bool {
+	_, ok := payloads[eventType]
+	return ok
+}
+
+// Aggregate types events are keyed by.
+const (
+	AggregateBooking = "booking"
+	AggregateGym     = "gym"
+)
+
+// New wraps payload in an envelope with a new event ID.
+func New(eventType string, version int32, aggregateType, aggregateID string, payload proto.Message) (*booking.EventEnvelope, error) {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s event: %w", eventType, err)
+	}
+
+	return &booking.EventEnvelope{
+		Id:            uuid.New().String(),
+		Type:          eventType,
+		Version:       version,
+		AggregateType: aggregateType,
+		AggregateId:   aggregateID,
+		OccurredAt:    time.Now().UTC().Format(time.RFC3339),
+		Payload:       data,
+	}, nil
+}
+
+// Payload decodes the envelope's payload into the message for its type and
+// version.
+func Payload(envelope *booking.EventEnvelope) (proto.Message, error) {
+	newPayload, ok := payloads[envelope.Type][envelope.Version]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %s v%d", envelope.Type, envelope.Version)
+	}
+
+	msg := newPayload()
+	if err := Decode(envelope, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Decode unmarshals the envelope's payload into msg, which must be the
+// message for the envelope's type and version.
+func Decode(envelope *booking.EventEnvelope, msg proto.Message) error {
+	if err := proto.Unmarshal(envelope.Payload, msg); err != nil {
+		return fmt.Errorf("error decoding %s v%d event: %w", envelope.Type, envelope.Version, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Publisher delivers events to consumers. Publish returns once the event is
+// durably accepted; an error means it will be published again.
+type Publisher interface {
+	Publish(ctx context.Context, event *booking.EventEnvelope) error
+	Close() error
+}
+
+// Publisher kinds accepted by NewPublisher.
+const (
+	PublisherMemory = "memory"
+	PublisherFile   = "file"
+)
+
+// NewPublisher creates the publisher named by kind. path is the output file
+// of a file publisher.
+func NewPublisher(kind, path string) (Publisher, error) {
+	switch kind {
+	case PublisherMemory:
+		return NewMemoryPublisher(), nil
+	case PublisherFile:
+		return NewFilePublisher(path)
+	default:
+		return nil, fmt.Errorf("unknown event publisher %q", kind)
+	}
+}
+
+// MemoryPublisher keeps published events in memory, for tests.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []*booking.EventEnvelope
+}
+
+// NewMemoryPublisher creates an empty MemoryPublisher.
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish records event.
+func (p *MemoryPublisher) Publish(ctx context.Context, event *booking.EventEnvelope) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events returns the events published so far, oldest first.
+func (p *MemoryPublisher) Events() []*booking.EventEnvelope {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*booking.EventEnvelope(nil), p.events...)
+}
+
+// Close does nothing.
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
+
+// FilePublisher appends events to a file as JSON lines, for local runs.
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFilePublisher opens path for appending, creating it and its directory
+// if needed.
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("error creating event file directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening event file: %w", err)
+	}
+
+	return &FilePublisher{file: file}, nil
+}
+
+// Publish writes event as one line and syncs the file.
+func (p *FilePublisher) Publish(ctx context.Context, event *booking.EventEnvelope) error {
+	line, err := protojson.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error encoding event: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("error writing event: %w", err)
+	}
+	return p.file.Sync()
+}
+
+// Close closes the file.
+func (p *FilePublisher) Close() error {
+	return p.file.Close()
+}
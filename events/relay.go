@@ -0,0 +1,99 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+)
+
+// relayBatchSize is how many events the relay delivers per outbox read.
+const relayBatchSize = 100
+
+// Store is the outbox the relay reads from.
+type Store interface {
+	// DeliverPending passes up to limit pending events, oldest first, to
+	// deliver and marks those it accepts as published. It stops at the first
+	// event deliver fails and schedules it for a retry, so events are never
+	// published out of order, and returns that failure. It returns how many
+	// events were published.
+	DeliverPending(ctx context.Context, limit int, deliver func(*booking.EventEnvelope) error) (int, error)
+	// EnqueueExpiries writes a subscription expired event for up to limit
+	// bookings whose period has ended, at most once per booking, and returns
+	// how many were written.
+	EnqueueExpiries(ctx context.Context, limit int) (int, error)
+}
+
+// Relay moves events from the outbox to a publisher. An event is marked
+// published only after the publisher accepts it, so delivery is
+// at-least-once: a crash in between publishes it again.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	interval  time.Duration
+	logger    *slog.Logger
+}
+
+// NewRelay creates a Relay that polls store every interval.
+func NewRelay(store Store, publisher Publisher, interval time.Duration, logger *slog.Logger) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run sweeps for ended bookings and delivers pending events until ctx is
+// done. A flush under way when ctx is done is finished before Run returns.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	work := context.WithoutCancel(ctx)
+	for {
+		if _, err := r.Sweep(work); err != nil {
+			r.logger.ErrorContext(ctx, "error enqueueing expiries", "error", err)
+		}
+		if _, err := r.Flush(work); err != nil {
+			r.logger.ErrorContext(ctx, "error relaying events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep writes the events of bookings whose period has ended and returns how
+// many were written. They are delivered by the next Flush.
+func (r *Relay) Sweep(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := r.store.EnqueueExpiries(ctx, relayBatchSize)
+		total += n
+		if err != nil || n < relayBatchSize {
+			return total, err
+		}
+	}
+}
+
+// Flush delivers every event that is due now and returns how many were
+// published.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	deliver := func(event *booking.EventEnvelope) error {
+		return r.publisher.Publish(ctx, event)
+	}
+
+	total := 0
+	for {
+		n, err := r.store.DeliverPending(ctx, relayBatchSize, deliver)
+		total += n
+		if err != nil || n < relayBatchSize {
+			return total, err
+		}
+	}
+}
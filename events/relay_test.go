@@ -0,0 +1,186 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryStore is an outbox kept in memory. Failed events are due again
+// immediately. ended holds the bookings whose period has ended and that have
+// not expired yet.
+type memoryStore struct {
+	pending []*booking.EventEnvelope
+	ended   []string
+}
+
+func (s *memoryStore) EnqueueExpiries(ctx context.Context, limit int) (int, error) {
+	n := min(limit, len(s.ended))
+	for _, bookingID := range s.ended[:n] {
+		event, err := New(TypeSubscriptionExpired, 1, AggregateBooking, bookingID, &booking.SubscriptionExpiredV1{BookingId: bookingID, Reason: "period_ended"})
+		if err != nil {
+			return 0, err
+		}
+		s.pending = append(s.pending, event)
+	}
+	s.ended = s.ended[n:]
+	return n, nil
+}
+
+func (s *memoryStore) DeliverPending(ctx context.Context, limit int, deliver func(*booking.EventEnvelope) error) (int, error) {
+	published := 0
+	for published < len(s.pending) && published < limit {
+		if err := deliver(s.pending[published]); err != nil {
+			s.pending = s.pending[published:]
+			return published, err
+		}
+		published++
+	}
+	s.pending = s.pending[published:]
+	return published, nil
+}
+
+// flakyPublisher fails the first fail publishes.
+type flakyPublisher struct {
+	*MemoryPublisher
+	fail int
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event *booking.EventEnvelope) error {
+	if p.fail > 0 {
+		p.fail--
+		return errors.New("broker unavailable")
+	}
+	return p.MemoryPublisher.Publish(ctx, event)
+}
+
+// blockingPublisher signals started on its first publish and waits for
+// release, failing if the context it was given is cancelled first.
+type blockingPublisher struct {
+	*MemoryPublisher
+	started chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingPublisher) Publish(ctx context.Context, event *booking.EventEnvelope) error {
+	close(p.started)
+	<-p.release
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return p.MemoryPublisher.Publish(ctx, event)
+}
+
+func newTestEvent(t *testing.T, bookingID string) *booking.EventEnvelope {
+	event, err := New(TypeBookingCreated, 1, AggregateBooking, bookingID, &booking.BookingCreatedV1{BookingId: bookingID})
+	assert.NoError(t, err)
+	return event
+}
+
+func TestRelay(t *testing.T) {
+	t.Run("PublishesInOrder", func(t *testing.T) {
+		store := &memoryStore{}
+		for i := 0; i < relayBatchSize+1; i++ {
+			store.pending = append(store.pending, newTestEvent(t, "booking"))
+		}
+		publisher := NewMemoryPublisher()
+
+		n, err := NewRelay(store, publisher, time.Second, slog.Default()).Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, relayBatchSize+1, n)
+		assert.Empty(t, store.pending)
+		assert.Len(t, publisher.Events(), relayBatchSize+1)
+	})
+
+	t.Run("FailedEventIsRetried", func(t *testing.T) {
+		first, second := newTestEvent(t, "first"), newTestEvent(t, "second")
+		store := &memoryStore{pending: []*booking.EventEnvelope{first, second}}
+		publisher := &flakyPublisher{MemoryPublisher: NewMemoryPublisher(), fail: 1}
+		relay := NewRelay(store, publisher, time.Second, slog.Default())
+
+		_, err := relay.Flush(context.Background())
+		assert.Error(t, err)
+		assert.Len(t, store.pending, 2)
+
+		n, err := relay.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		if published := publisher.Events(); assert.Len(t, published, 2) {
+			assert.Equal(t, first.Id, published[0].Id)
+			assert.Equal(t, second.Id, published[1].Id)
+		}
+	})
+
+	t.Run("RunFinishesFlushOnShutdown", func(t *testing.T) {
+		store := &memoryStore{pending: []*booking.EventEnvelope{newTestEvent(t, "booking")}}
+		publisher := &blockingPublisher{MemoryPublisher: NewMemoryPublisher(), started: make(chan struct{}), release: make(chan struct{})}
+		relay := NewRelay(store, publisher, time.Hour, slog.Default())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			relay.Run(ctx)
+			close(done)
+		}()
+
+		// Shut down while the event is being published
+		<-publisher.started
+		cancel()
+		close(publisher.release)
+
+		<-done
+		assert.Empty(t, store.pending)
+		assert.Len(t, publisher.Events(), 1)
+	})
+
+	t.Run("SweepEnqueuesEndedBookings", func(t *testing.T) {
+		store := &memoryStore{}
+		for i := 0; i < relayBatchSize+1; i++ {
+			store.ended = append(store.ended, "booking")
+		}
+		publisher := NewMemoryPublisher()
+		relay := NewRelay(store, publisher, time.Second, slog.Default())
+
+		n, err := relay.Sweep(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, relayBatchSize+1, n)
+
+		_, err = relay.Flush(context.Background())
+		assert.NoError(t, err)
+		if assert.Len(t, publisher.Events(), relayBatchSize+1) {
+			assert.Equal(t, TypeSubscriptionExpired, publisher.Events()[0].Type)
+		}
+	})
+
+	t.Run("DecodeVersionedPayload", func(t *testing.T) {
+		event := newTestEvent(t, "booking-1")
+
+		var created booking.BookingCreatedV1
+		assert.NoError(t, Decode(event, &created))
+		assert.Equal(t, "booking-1", created.BookingId)
+		assert.Equal(t, TypeBookingCreated, event.Type)
+		assert.Equal(t, int32(1), event.Version)
+	})
+
+	t.Run("FilePublisherWritesLines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "events", "events.jsonl")
+		publisher, err := NewPublisher(PublisherFile, path)
+		assert.NoError(t, err)
+
+		assert.NoError(t, publisher.Publish(context.Background(), newTestEvent(t, "first")))
+		assert.NoError(t, publisher.Publish(context.Background(), newTestEvent(t, "second")))
+		assert.NoError(t, publisher.Close())
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 2)
+	})
+}
@@ -7,6 +7,7 @@
 package booking
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -27,6 +28,7 @@ type AccessBetaPersonalRequest struct {
 
 	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	SportHallId string `protobuf:"bytes,2,opt,name=sport_hall_id,json=sportHallId,proto3" json:"sport_hall_id,omitempty"`
+	DeviceId    string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // the turnstile or reader that scanned, for the audit trail
 }
 
 func (x *AccessBetaPersonalRequest) Reset() {
@@ -75,12 +77,21 @@ func (x *AccessBetaPersonalRequest) GetSportHallId() string {
 	return ""
 }
 
+func (x *AccessBetaPersonalRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type AccessBetaPersonalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "granted" or "denied"
+	Message          string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                                             // "granted" or "denied"
+	BundlePurchaseId string `protobuf:"bytes,2,opt,name=bundle_purchase_id,json=bundlePurchaseId,proto3" json:"bundle_purchase_id,omitempty"` // the bundle the granting booking belongs to, if any
+	Reason           string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                               // why access was denied, e.g. "outside allowed hours"
 }
 
 func (x *AccessBetaPersonalResponse) Reset() {
@@ -122,29 +133,349 @@ func (x *AccessBetaPersonalResponse) GetMessage() string {
 	return ""
 }
 
+func (x *AccessBetaPersonalResponse) GetBundlePurchaseId() string {
+	if x != nil {
+		return x.BundlePurchaseId
+	}
+	return ""
+}
+
+func (x *AccessBetaPersonalResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// IssueCheckInTokenRequest asks for a token for the calling member, who is
+// identified by the x-actor-id of the request.
+type IssueCheckInTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SportHallId string `protobuf:"bytes,2,opt,name=sport_hall_id,json=sportHallId,proto3" json:"sport_hall_id,omitempty"`
+}
+
+func (x *IssueCheckInTokenRequest) Reset() {
+	*x = IssueCheckInTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_access_beta_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueCheckInTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCheckInTokenRequest) ProtoMessage() {}
+
+func (x *IssueCheckInTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_access_beta_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCheckInTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueCheckInTokenRequest) Descriptor() ([]byte, []int) {
+	return file_protos_access_beta_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IssueCheckInTokenRequest) GetSportHallId() string {
+	if x != nil {
+		return x.SportHallId
+	}
+	return ""
+}
+
+// CheckInToken is shown by the member app as a QR code. It rotates every
+// period and can be used once.
+type CheckInToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *CheckInToken) Reset() {
+	*x = CheckInToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_access_beta_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckInToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInToken) ProtoMessage() {}
+
+func (x *CheckInToken) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_access_beta_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInToken.ProtoReflect.Descriptor instead.
+func (*CheckInToken) Descriptor() ([]byte, []int) {
+	return file_protos_access_beta_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckInToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInToken) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type CheckInWithTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SportHallId string `protobuf:"bytes,2,opt,name=sport_hall_id,json=sportHallId,proto3" json:"sport_hall_id,omitempty"` // the hall the turnstile belongs to
+	DeviceId    string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (x *CheckInWithTokenRequest) Reset() {
+	*x = CheckInWithTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_access_beta_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckInWithTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInWithTokenRequest) ProtoMessage() {}
+
+func (x *CheckInWithTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_access_beta_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInWithTokenRequest.ProtoReflect.Descriptor instead.
+func (*CheckInWithTokenRequest) Descriptor() ([]byte, []int) {
+	return file_protos_access_beta_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckInWithTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInWithTokenRequest) GetSportHallId() string {
+	if x != nil {
+		return x.SportHallId
+	}
+	return ""
+}
+
+func (x *CheckInWithTokenRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+// FaceCheckInRequest is sent by a face recognition device at the turnstile.
+type FaceCheckInRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FaceId      string  `protobuf:"bytes,1,opt,name=face_id,json=faceId,proto3" json:"face_id,omitempty"` // the identifier the device matched, stored in users.face_id
+	Confidence  float32 `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`     // match confidence between 0 and 1
+	DeviceId    string  `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	SportHallId string  `protobuf:"bytes,4,opt,name=sport_hall_id,json=sportHallId,proto3" json:"sport_hall_id,omitempty"`
+}
+
+func (x *FaceCheckInRequest) Reset() {
+	*x = FaceCheckInRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_access_beta_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaceCheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaceCheckInRequest) ProtoMessage() {}
+
+func (x *FaceCheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_access_beta_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaceCheckInRequest.ProtoReflect.Descriptor instead.
+func (*FaceCheckInRequest) Descriptor() ([]byte, []int) {
+	return file_protos_access_beta_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FaceCheckInRequest) GetFaceId() string {
+	if x != nil {
+		return x.FaceId
+	}
+	return ""
+}
+
+func (x *FaceCheckInRequest) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *FaceCheckInRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *FaceCheckInRequest) GetSportHallId() string {
+	if x != nil {
+		return x.SportHallId
+	}
+	return ""
+}
+
 var File_protos_access_beta_proto protoreflect.FileDescriptor
 
 var file_protos_access_beta_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x62, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x79, 0x6d, 0x22,
-	0x58, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68,
-	0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x32, 0x67, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x42, 0x65, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x12, 0x5a, 0x10, 0x67, 0x65,
-	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x79, 0x6d, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x75, 0x0a,
+	0x19, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x48, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65,
+	0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x18, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x49, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x6c, 0x6c,
+	0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0x43, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x70, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x46, 0x61, 0x63,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68,
+	0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x32, 0xdc, 0x04, 0x0a, 0x11, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x74, 0x61, 0x12,
+	0x73, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42,
+	0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42,
+	0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x3a, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x70, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
+	0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x3a, 0x65, 0x78, 0x69, 0x74, 0x12, 0x69, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x67, 0x79,
+	0x6d, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x67, 0x79, 0x6d,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2d, 0x69, 0x6e, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x7d, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22,
+	0x1f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x3a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x76, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x46,
+	0x61, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
+	0x79, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x74, 0x61, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x3a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x46, 0x61, 0x63, 0x65, 0x42, 0x12, 0x5a, 0x10, 0x67, 0x65, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -159,16 +490,28 @@ func file_protos_access_beta_proto_rawDescGZIP() []byte {
 	return file_protos_access_beta_proto_rawDescData
 }
 
-var file_protos_access_beta_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protos_access_beta_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_protos_access_beta_proto_goTypes = []any{
 	(*AccessBetaPersonalRequest)(nil),  // 0: gym.AccessBetaPersonalRequest
 	(*AccessBetaPersonalResponse)(nil), // 1: gym.AccessBetaPersonalResponse
+	(*IssueCheckInTokenRequest)(nil),   // 2: gym.IssueCheckInTokenRequest
+	(*CheckInToken)(nil),               // 3: gym.CheckInToken
+	(*CheckInWithTokenRequest)(nil),    // 4: gym.CheckInWithTokenRequest
+	(*FaceCheckInRequest)(nil),         // 5: gym.FaceCheckInRequest
 }
 var file_protos_access_beta_proto_depIdxs = []int32{
 	0, // 0: gym.AccessServiceBeta.CheckUserAccess:input_type -> gym.AccessBetaPersonalRequest
-	1, // 1: gym.AccessServiceBeta.CheckUserAccess:output_type -> gym.AccessBetaPersonalResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	0, // 1: gym.AccessServiceBeta.CheckUserExit:input_type -> gym.AccessBetaPersonalRequest
+	2, // 2: gym.AccessServiceBeta.IssueCheckInToken:input_type -> gym.IssueCheckInTokenRequest
+	4, // 3: gym.AccessServiceBeta.CheckInWithToken:input_type -> gym.CheckInWithTokenRequest
+	5, // 4: gym.AccessServiceBeta.CheckInWithFace:input_type -> gym.FaceCheckInRequest
+	1, // 5: gym.AccessServiceBeta.CheckUserAccess:output_type -> gym.AccessBetaPersonalResponse
+	1, // 6: gym.AccessServiceBeta.CheckUserExit:output_type -> gym.AccessBetaPersonalResponse
+	3, // 7: gym.AccessServiceBeta.IssueCheckInToken:output_type -> gym.CheckInToken
+	1, // 8: gym.AccessServiceBeta.CheckInWithToken:output_type -> gym.AccessBetaPersonalResponse
+	1, // 9: gym.AccessServiceBeta.CheckInWithFace:output_type -> gym.AccessBetaPersonalResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -204,6 +547,54 @@ func file_protos_access_beta_proto_init() {
 				return nil
 			}
 		}
+		file_protos_access_beta_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*IssueCheckInTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_access_beta_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckInToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_access_beta_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckInWithTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_access_beta_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*FaceCheckInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -211,7 +602,7 @@ func file_protos_access_beta_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_access_beta_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AccessServiceBeta_CheckUserAccess_FullMethodName = "/gym.AccessServiceBeta/CheckUserAccess"
+	AccessServiceBeta_CheckUserAccess_FullMethodName   = "/gym.AccessServiceBeta/CheckUserAccess"
+	AccessServiceBeta_CheckUserExit_FullMethodName     = "/gym.AccessServiceBeta/CheckUserExit"
+	AccessServiceBeta_IssueCheckInToken_FullMethodName = "/gym.AccessServiceBeta/IssueCheckInToken"
+	AccessServiceBeta_CheckInWithToken_FullMethodName  = "/gym.AccessServiceBeta/CheckInWithToken"
+	AccessServiceBeta_CheckInWithFace_FullMethodName   = "/gym.AccessServiceBeta/CheckInWithFace"
 )
 
 // AccessServiceBetaClient is the client API for AccessServiceBeta service.
@@ -27,6 +31,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AccessServiceBetaClient interface {
 	CheckUserAccess(ctx context.Context, in *AccessBetaPersonalRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error)
+	CheckUserExit(ctx context.Context, in *AccessBetaPersonalRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error)
+	IssueCheckInToken(ctx context.Context, in *IssueCheckInTokenRequest, opts ...grpc.CallOption) (*CheckInToken, error)
+	CheckInWithToken(ctx context.Context, in *CheckInWithTokenRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error)
+	CheckInWithFace(ctx context.Context, in *FaceCheckInRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error)
 }
 
 type accessServiceBetaClient struct {
@@ -47,11 +55,55 @@ func (c *accessServiceBetaClient) CheckUserAccess(ctx context.Context, in *Acces
 	return out, nil
 }
 
+func (c *accessServiceBetaClient) CheckUserExit(ctx context.Context, in *AccessBetaPersonalRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessBetaPersonalResponse)
+	err := c.cc.Invoke(ctx, AccessServiceBeta_CheckUserExit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessServiceBetaClient) IssueCheckInToken(ctx context.Context, in *IssueCheckInTokenRequest, opts ...grpc.CallOption) (*CheckInToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInToken)
+	err := c.cc.Invoke(ctx, AccessServiceBeta_IssueCheckInToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessServiceBetaClient) CheckInWithToken(ctx context.Context, in *CheckInWithTokenRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessBetaPersonalResponse)
+	err := c.cc.Invoke(ctx, AccessServiceBeta_CheckInWithToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessServiceBetaClient) CheckInWithFace(ctx context.Context, in *FaceCheckInRequest, opts ...grpc.CallOption) (*AccessBetaPersonalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessBetaPersonalResponse)
+	err := c.cc.Invoke(ctx, AccessServiceBeta_CheckInWithFace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessServiceBetaServer is the server API for AccessServiceBeta service.
 // All implementations must embed UnimplementedAccessServiceBetaServer
 // for forward compatibility.
 type AccessServiceBetaServer interface {
 	CheckUserAccess(context.Context, *AccessBetaPersonalRequest) (*AccessBetaPersonalResponse, error)
+	CheckUserExit(context.Context, *AccessBetaPersonalRequest) (*AccessBetaPersonalResponse, error)
+	IssueCheckInToken(context.Context, *IssueCheckInTokenRequest) (*CheckInToken, error)
+	CheckInWithToken(context.Context, *CheckInWithTokenRequest) (*AccessBetaPersonalResponse, error)
+	CheckInWithFace(context.Context, *FaceCheckInRequest) (*AccessBetaPersonalResponse, error)
 	mustEmbedUnimplementedAccessServiceBetaServer()
 }
 
@@ -65,6 +117,18 @@ type UnimplementedAccessServiceBetaServer struct{}
 func (UnimplementedAccessServiceBetaServer) CheckUserAccess(context.Context, *AccessBetaPersonalRequest) (*AccessBetaPersonalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckUserAccess not implemented")
 }
+func (UnimplementedAccessServiceBetaServer) CheckUserExit(context.Context, *AccessBetaPersonalRequest) (*AccessBetaPersonalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserExit not implemented")
+}
+func (UnimplementedAccessServiceBetaServer) IssueCheckInToken(context.Context, *IssueCheckInTokenRequest) (*CheckInToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCheckInToken not implemented")
+}
+func (UnimplementedAccessServiceBetaServer) CheckInWithToken(context.Context, *CheckInWithTokenRequest) (*AccessBetaPersonalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInWithToken not implemented")
+}
+func (UnimplementedAccessServiceBetaServer) CheckInWithFace(context.Context, *FaceCheckInRequest) (*AccessBetaPersonalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInWithFace not implemented")
+}
 func (UnimplementedAccessServiceBetaServer) mustEmbedUnimplementedAccessServiceBetaServer() {}
 func (UnimplementedAccessServiceBetaServer) testEmbeddedByValue()                           {}
 
@@ -104,6 +168,78 @@ func _AccessServiceBeta_CheckUserAccess_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessServiceBeta_CheckUserExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessBetaPersonalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceBetaServer).CheckUserExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessServiceBeta_CheckUserExit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceBetaServer).CheckUserExit(ctx, req.(*AccessBetaPersonalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessServiceBeta_IssueCheckInToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCheckInTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceBetaServer).IssueCheckInToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessServiceBeta_IssueCheckInToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceBetaServer).IssueCheckInToken(ctx, req.(*IssueCheckInTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessServiceBeta_CheckInWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInWithTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceBetaServer).CheckInWithToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessServiceBeta_CheckInWithToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceBetaServer).CheckInWithToken(ctx, req.(*CheckInWithTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessServiceBeta_CheckInWithFace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaceCheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceBetaServer).CheckInWithFace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessServiceBeta_CheckInWithFace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceBetaServer).CheckInWithFace(ctx, req.(*FaceCheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccessServiceBeta_ServiceDesc is the grpc.ServiceDesc for AccessServiceBeta service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +251,22 @@ var AccessServiceBeta_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckUserAccess",
 			Handler:    _AccessServiceBeta_CheckUserAccess_Handler,
 		},
+		{
+			MethodName: "CheckUserExit",
+			Handler:    _AccessServiceBeta_CheckUserExit_Handler,
+		},
+		{
+			MethodName: "IssueCheckInToken",
+			Handler:    _AccessServiceBeta_IssueCheckInToken_Handler,
+		},
+		{
+			MethodName: "CheckInWithToken",
+			Handler:    _AccessServiceBeta_CheckInWithToken_Handler,
+		},
+		{
+			MethodName: "CheckInWithFace",
+			Handler:    _AccessServiceBeta_CheckInWithFace_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/access_beta.proto",
@@ -25,6 +25,9 @@ const (
 	AccessService_ListAccessGroup_FullMethodName      = "/gym.AccessService/ListAccessGroup"
 	AccessService_CreateAccessCoach_FullMethodName    = "/gym.AccessService/CreateAccessCoach"
 	AccessService_ListAccessCoach_FullMethodName      = "/gym.AccessService/ListAccessCoach"
+	AccessService_StreamAccessEvents_FullMethodName   = "/gym.AccessService/StreamAccessEvents"
+	AccessService_ListAccessHistory_FullMethodName    = "/gym.AccessService/ListAccessHistory"
+	AccessService_ListSuspiciousAccess_FullMethodName = "/gym.AccessService/ListSuspiciousAccess"
 )
 
 // AccessServiceClient is the client API for AccessService service.
@@ -37,6 +40,9 @@ type AccessServiceClient interface {
 	ListAccessGroup(ctx context.Context, in *ListAccessGroupRequest, opts ...grpc.CallOption) (*ListAccessGroupResponse, error)
 	CreateAccessCoach(ctx context.Context, in *CreateAccessCoachRequest, opts ...grpc.CallOption) (*AccessCoach, error)
 	ListAccessCoach(ctx context.Context, in *ListAccessCoachRequest, opts ...grpc.CallOption) (*ListAccessCoachResponse, error)
+	StreamAccessEvents(ctx context.Context, in *StreamAccessEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccessEvent], error)
+	ListAccessHistory(ctx context.Context, in *ListAccessHistoryRequest, opts ...grpc.CallOption) (*ListAccessHistoryResponse, error)
+	ListSuspiciousAccess(ctx context.Context, in *ListSuspiciousAccessRequest, opts ...grpc.CallOption) (*ListSuspiciousAccessResponse, error)
 }
 
 type accessServiceClient struct {
@@ -107,6 +113,45 @@ func (c *accessServiceClient) ListAccessCoach(ctx context.Context, in *ListAcces
 	return out, nil
 }
 
+func (c *accessServiceClient) StreamAccessEvents(ctx context.Context, in *StreamAccessEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccessEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AccessService_ServiceDesc.Streams[0], AccessService_StreamAccessEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamAccessEventsRequest, AccessEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccessService_StreamAccessEventsClient = grpc.ServerStreamingClient[AccessEvent]
+
+func (c *accessServiceClient) ListAccessHistory(ctx context.Context, in *ListAccessHistoryRequest, opts ...grpc.CallOption) (*ListAccessHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessHistoryResponse)
+	err := c.cc.Invoke(ctx, AccessService_ListAccessHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessServiceClient) ListSuspiciousAccess(ctx context.Context, in *ListSuspiciousAccessRequest, opts ...grpc.CallOption) (*ListSuspiciousAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuspiciousAccessResponse)
+	err := c.cc.Invoke(ctx, AccessService_ListSuspiciousAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessServiceServer is the server API for AccessService service.
 // All implementations must embed UnimplementedAccessServiceServer
 // for forward compatibility.
@@ -117,6 +162,9 @@ type AccessServiceServer interface {
 	ListAccessGroup(context.Context, *ListAccessGroupRequest) (*ListAccessGroupResponse, error)
 	CreateAccessCoach(context.Context, *CreateAccessCoachRequest) (*AccessCoach, error)
 	ListAccessCoach(context.Context, *ListAccessCoachRequest) (*ListAccessCoachResponse, error)
+	StreamAccessEvents(*StreamAccessEventsRequest, grpc.ServerStreamingServer[AccessEvent]) error
+	ListAccessHistory(context.Context, *ListAccessHistoryRequest) (*ListAccessHistoryResponse, error)
+	ListSuspiciousAccess(context.Context, *ListSuspiciousAccessRequest) (*ListSuspiciousAccessResponse, error)
 	mustEmbedUnimplementedAccessServiceServer()
 }
 
@@ -145,6 +193,15 @@ func (UnimplementedAccessServiceServer) CreateAccessCoach(context.Context, *Crea
 func (UnimplementedAccessServiceServer) ListAccessCoach(context.Context, *ListAccessCoachRequest) (*ListAccessCoachResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccessCoach not implemented")
 }
+func (UnimplementedAccessServiceServer) StreamAccessEvents(*StreamAccessEventsRequest, grpc.ServerStreamingServer[AccessEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAccessEvents not implemented")
+}
+func (UnimplementedAccessServiceServer) ListAccessHistory(context.Context, *ListAccessHistoryRequest) (*ListAccessHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessHistory not implemented")
+}
+func (UnimplementedAccessServiceServer) ListSuspiciousAccess(context.Context, *ListSuspiciousAccessRequest) (*ListSuspiciousAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuspiciousAccess not implemented")
+}
 func (UnimplementedAccessServiceServer) mustEmbedUnimplementedAccessServiceServer() {}
 func (UnimplementedAccessServiceServer) testEmbeddedByValue()                       {}
 
@@ -274,6 +331,53 @@ func _AccessService_ListAccessCoach_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessService_StreamAccessEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAccessEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccessServiceServer).StreamAccessEvents(m, &grpc.GenericServerStream[StreamAccessEventsRequest, AccessEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AccessService_StreamAccessEventsServer = grpc.ServerStreamingServer[AccessEvent]
+
+func _AccessService_ListAccessHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceServer).ListAccessHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessService_ListAccessHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceServer).ListAccessHistory(ctx, req.(*ListAccessHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessService_ListSuspiciousAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuspiciousAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceServer).ListSuspiciousAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessService_ListSuspiciousAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceServer).ListSuspiciousAccess(ctx, req.(*ListSuspiciousAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccessService_ServiceDesc is the grpc.ServiceDesc for AccessService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,7 +409,21 @@ var AccessService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAccessCoach",
 			Handler:    _AccessService_ListAccessCoach_Handler,
 		},
+		{
+			MethodName: "ListAccessHistory",
+			Handler:    _AccessService_ListAccessHistory_Handler,
+		},
+		{
+			MethodName: "ListSuspiciousAccess",
+			Handler:    _AccessService_ListSuspiciousAccess_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAccessEvents",
+			Handler:       _AccessService_StreamAccessEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/access.proto",
 }
@@ -24,6 +24,7 @@ const (
 	BookingPersonalService_UpdateBookingPersonal_FullMethodName = "/gym.BookingPersonalService/UpdateBookingPersonal"
 	BookingPersonalService_DeleteBookingPersonal_FullMethodName = "/gym.BookingPersonalService/DeleteBookingPersonal"
 	BookingPersonalService_ListBookingPersonal_FullMethodName   = "/gym.BookingPersonalService/ListBookingPersonal"
+	BookingPersonalService_ChangePlan_FullMethodName            = "/gym.BookingPersonalService/ChangePlan"
 )
 
 // BookingPersonalServiceClient is the client API for BookingPersonalService service.
@@ -35,6 +36,7 @@ type BookingPersonalServiceClient interface {
 	UpdateBookingPersonal(ctx context.Context, in *UpdateBookingPersonalRequest, opts ...grpc.CallOption) (*BookingPersonal, error)
 	DeleteBookingPersonal(ctx context.Context, in *DeleteBookingPersonalRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListBookingPersonal(ctx context.Context, in *ListBookingPersonalRequest, opts ...grpc.CallOption) (*ListBookingPersonalResponse, error)
+	ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*ChangePlanResponse, error)
 }
 
 type bookingPersonalServiceClient struct {
@@ -95,6 +97,16 @@ func (c *bookingPersonalServiceClient) ListBookingPersonal(ctx context.Context,
 	return out, nil
 }
 
+func (c *bookingPersonalServiceClient) ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*ChangePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePlanResponse)
+	err := c.cc.Invoke(ctx, BookingPersonalService_ChangePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingPersonalServiceServer is the server API for BookingPersonalService service.
 // All implementations must embed UnimplementedBookingPersonalServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type BookingPersonalServiceServer interface {
 	UpdateBookingPersonal(context.Context, *UpdateBookingPersonalRequest) (*BookingPersonal, error)
 	DeleteBookingPersonal(context.Context, *DeleteBookingPersonalRequest) (*Empty, error)
 	ListBookingPersonal(context.Context, *ListBookingPersonalRequest) (*ListBookingPersonalResponse, error)
+	ChangePlan(context.Context, *ChangePlanRequest) (*ChangePlanResponse, error)
 	mustEmbedUnimplementedBookingPersonalServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedBookingPersonalServiceServer) DeleteBookingPersonal(context.C
 func (UnimplementedBookingPersonalServiceServer) ListBookingPersonal(context.Context, *ListBookingPersonalRequest) (*ListBookingPersonalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookingPersonal not implemented")
 }
+func (UnimplementedBookingPersonalServiceServer) ChangePlan(context.Context, *ChangePlanRequest) (*ChangePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePlan not implemented")
+}
 func (UnimplementedBookingPersonalServiceServer) mustEmbedUnimplementedBookingPersonalServiceServer() {
 }
 func (UnimplementedBookingPersonalServiceServer) testEmbeddedByValue() {}
@@ -241,6 +257,24 @@ func _BookingPersonalService_ListBookingPersonal_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingPersonalService_ChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingPersonalServiceServer).ChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingPersonalService_ChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingPersonalServiceServer).ChangePlan(ctx, req.(*ChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingPersonalService_ServiceDesc is the grpc.ServiceDesc for BookingPersonalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +302,10 @@ var BookingPersonalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBookingPersonal",
 			Handler:    _BookingPersonalService_ListBookingPersonal_Handler,
 		},
+		{
+			MethodName: "ChangePlan",
+			Handler:    _BookingPersonalService_ChangePlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/booking.proto",
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/booking_member.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BookingMemberService_AddBookingMember_FullMethodName    = "/gym.BookingMemberService/AddBookingMember"
+	BookingMemberService_RemoveBookingMember_FullMethodName = "/gym.BookingMemberService/RemoveBookingMember"
+	BookingMemberService_ListBookingMembers_FullMethodName  = "/gym.BookingMemberService/ListBookingMembers"
+)
+
+// BookingMemberServiceClient is the client API for BookingMemberService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookingMemberServiceClient interface {
+	AddBookingMember(ctx context.Context, in *AddBookingMemberRequest, opts ...grpc.CallOption) (*BookingMember, error)
+	RemoveBookingMember(ctx context.Context, in *RemoveBookingMemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListBookingMembers(ctx context.Context, in *ListBookingMembersRequest, opts ...grpc.CallOption) (*ListBookingMembersResponse, error)
+}
+
+type bookingMemberServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingMemberServiceClient(cc grpc.ClientConnInterface) BookingMemberServiceClient {
+	return &bookingMemberServiceClient{cc}
+}
+
+func (c *bookingMemberServiceClient) AddBookingMember(ctx context.Context, in *AddBookingMemberRequest, opts ...grpc.CallOption) (*BookingMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingMember)
+	err := c.cc.Invoke(ctx, BookingMemberService_AddBookingMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingMemberServiceClient) RemoveBookingMember(ctx context.Context, in *RemoveBookingMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, BookingMemberService_RemoveBookingMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingMemberServiceClient) ListBookingMembers(ctx context.Context, in *ListBookingMembersRequest, opts ...grpc.CallOption) (*ListBookingMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingMembersResponse)
+	err := c.cc.Invoke(ctx, BookingMemberService_ListBookingMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingMemberServiceServer is the server API for BookingMemberService service.
+// All implementations must embed UnimplementedBookingMemberServiceServer
+// for forward compatibility.
+type BookingMemberServiceServer interface {
+	AddBookingMember(context.Context, *AddBookingMemberRequest) (*BookingMember, error)
+	RemoveBookingMember(context.Context, *RemoveBookingMemberRequest) (*Empty, error)
+	ListBookingMembers(context.Context, *ListBookingMembersRequest) (*ListBookingMembersResponse, error)
+	mustEmbedUnimplementedBookingMemberServiceServer()
+}
+
+// UnimplementedBookingMemberServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingMemberServiceServer struct{}
+
+func (UnimplementedBookingMemberServiceServer) AddBookingMember(context.Context, *AddBookingMemberRequest) (*BookingMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookingMember not implemented")
+}
+func (UnimplementedBookingMemberServiceServer) RemoveBookingMember(context.Context, *RemoveBookingMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookingMember not implemented")
+}
+func (UnimplementedBookingMemberServiceServer) ListBookingMembers(context.Context, *ListBookingMembersRequest) (*ListBookingMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookingMembers not implemented")
+}
+func (UnimplementedBookingMemberServiceServer) mustEmbedUnimplementedBookingMemberServiceServer() {}
+func (UnimplementedBookingMemberServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeBookingMemberServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingMemberServiceServer will
+// result in compilation errors.
+type UnsafeBookingMemberServiceServer interface {
+	mustEmbedUnimplementedBookingMemberServiceServer()
+}
+
+func RegisterBookingMemberServiceServer(s grpc.ServiceRegistrar, srv BookingMemberServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingMemberServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingMemberService_ServiceDesc, srv)
+}
+
+func _BookingMemberService_AddBookingMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookingMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingMemberServiceServer).AddBookingMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingMemberService_AddBookingMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingMemberServiceServer).AddBookingMember(ctx, req.(*AddBookingMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingMemberService_RemoveBookingMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookingMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingMemberServiceServer).RemoveBookingMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingMemberService_RemoveBookingMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingMemberServiceServer).RemoveBookingMember(ctx, req.(*RemoveBookingMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingMemberService_ListBookingMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingMemberServiceServer).ListBookingMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingMemberService_ListBookingMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingMemberServiceServer).ListBookingMembers(ctx, req.(*ListBookingMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingMemberService_ServiceDesc is the grpc.ServiceDesc for BookingMemberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingMemberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.BookingMemberService",
+	HandlerType: (*BookingMemberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddBookingMember",
+			Handler:    _BookingMemberService_AddBookingMember_Handler,
+		},
+		{
+			MethodName: "RemoveBookingMember",
+			Handler:    _BookingMemberService_RemoveBookingMember_Handler,
+		},
+		{
+			MethodName: "ListBookingMembers",
+			Handler:    _BookingMemberService_ListBookingMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/booking_member.proto",
+}
@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/booking_transfer.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BookingTransferService_SetTransferRule_FullMethodName      = "/gym.BookingTransferService/SetTransferRule"
+	BookingTransferService_GetTransferRule_FullMethodName      = "/gym.BookingTransferService/GetTransferRule"
+	BookingTransferService_TransferBooking_FullMethodName      = "/gym.BookingTransferService/TransferBooking"
+	BookingTransferService_ListBookingTransfers_FullMethodName = "/gym.BookingTransferService/ListBookingTransfers"
+)
+
+// BookingTransferServiceClient is the client API for BookingTransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookingTransferServiceClient interface {
+	SetTransferRule(ctx context.Context, in *SetTransferRuleRequest, opts ...grpc.CallOption) (*TransferRule, error)
+	GetTransferRule(ctx context.Context, in *GetTransferRuleRequest, opts ...grpc.CallOption) (*TransferRule, error)
+	TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...grpc.CallOption) (*BookingTransfer, error)
+	ListBookingTransfers(ctx context.Context, in *ListBookingTransfersRequest, opts ...grpc.CallOption) (*ListBookingTransfersResponse, error)
+}
+
+type bookingTransferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingTransferServiceClient(cc grpc.ClientConnInterface) BookingTransferServiceClient {
+	return &bookingTransferServiceClient{cc}
+}
+
+func (c *bookingTransferServiceClient) SetTransferRule(ctx context.Context, in *SetTransferRuleRequest, opts ...grpc.CallOption) (*TransferRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferRule)
+	err := c.cc.Invoke(ctx, BookingTransferService_SetTransferRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingTransferServiceClient) GetTransferRule(ctx context.Context, in *GetTransferRuleRequest, opts ...grpc.CallOption) (*TransferRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferRule)
+	err := c.cc.Invoke(ctx, BookingTransferService_GetTransferRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingTransferServiceClient) TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...grpc.CallOption) (*BookingTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingTransfer)
+	err := c.cc.Invoke(ctx, BookingTransferService_TransferBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingTransferServiceClient) ListBookingTransfers(ctx context.Context, in *ListBookingTransfersRequest, opts ...grpc.CallOption) (*ListBookingTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingTransfersResponse)
+	err := c.cc.Invoke(ctx, BookingTransferService_ListBookingTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingTransferServiceServer is the server API for BookingTransferService service.
+// All implementations must embed UnimplementedBookingTransferServiceServer
+// for forward compatibility.
+type BookingTransferServiceServer interface {
+	SetTransferRule(context.Context, *SetTransferRuleRequest) (*TransferRule, error)
+	GetTransferRule(context.Context, *GetTransferRuleRequest) (*TransferRule, error)
+	TransferBooking(context.Context, *TransferBookingRequest) (*BookingTransfer, error)
+	ListBookingTransfers(context.Context, *ListBookingTransfersRequest) (*ListBookingTransfersResponse, error)
+	mustEmbedUnimplementedBookingTransferServiceServer()
+}
+
+// UnimplementedBookingTransferServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingTransferServiceServer struct{}
+
+func (UnimplementedBookingTransferServiceServer) SetTransferRule(context.Context, *SetTransferRuleRequest) (*TransferRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferRule not implemented")
+}
+func (UnimplementedBookingTransferServiceServer) GetTransferRule(context.Context, *GetTransferRuleRequest) (*TransferRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferRule not implemented")
+}
+func (UnimplementedBookingTransferServiceServer) TransferBooking(context.Context, *TransferBookingRequest) (*BookingTransfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferBooking not implemented")
+}
+func (UnimplementedBookingTransferServiceServer) ListBookingTransfers(context.Context, *ListBookingTransfersRequest) (*ListBookingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookingTransfers not implemented")
+}
+func (UnimplementedBookingTransferServiceServer) mustEmbedUnimplementedBookingTransferServiceServer() {
+}
+func (UnimplementedBookingTransferServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBookingTransferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingTransferServiceServer will
+// result in compilation errors.
+type UnsafeBookingTransferServiceServer interface {
+	mustEmbedUnimplementedBookingTransferServiceServer()
+}
+
+func RegisterBookingTransferServiceServer(s grpc.ServiceRegistrar, srv BookingTransferServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingTransferServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingTransferService_ServiceDesc, srv)
+}
+
+func _BookingTransferService_SetTransferRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingTransferServiceServer).SetTransferRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingTransferService_SetTransferRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingTransferServiceServer).SetTransferRule(ctx, req.(*SetTransferRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingTransferService_GetTransferRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingTransferServiceServer).GetTransferRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingTransferService_GetTransferRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingTransferServiceServer).GetTransferRule(ctx, req.(*GetTransferRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingTransferService_TransferBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingTransferServiceServer).TransferBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingTransferService_TransferBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingTransferServiceServer).TransferBooking(ctx, req.(*TransferBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingTransferService_ListBookingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingTransferServiceServer).ListBookingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingTransferService_ListBookingTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingTransferServiceServer).ListBookingTransfers(ctx, req.(*ListBookingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingTransferService_ServiceDesc is the grpc.ServiceDesc for BookingTransferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingTransferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.BookingTransferService",
+	HandlerType: (*BookingTransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetTransferRule",
+			Handler:    _BookingTransferService_SetTransferRule_Handler,
+		},
+		{
+			MethodName: "GetTransferRule",
+			Handler:    _BookingTransferService_GetTransferRule_Handler,
+		},
+		{
+			MethodName: "TransferBooking",
+			Handler:    _BookingTransferService_TransferBooking_Handler,
+		},
+		{
+			MethodName: "ListBookingTransfers",
+			Handler:    _BookingTransferService_ListBookingTransfers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/booking_transfer.proto",
+}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/bundle.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BundleService_CreateBundle_FullMethodName        = "/gym.BundleService/CreateBundle"
+	BundleService_GetBundle_FullMethodName           = "/gym.BundleService/GetBundle"
+	BundleService_DeleteBundle_FullMethodName        = "/gym.BundleService/DeleteBundle"
+	BundleService_ListBundles_FullMethodName         = "/gym.BundleService/ListBundles"
+	BundleService_PurchaseBundle_FullMethodName      = "/gym.BundleService/PurchaseBundle"
+	BundleService_GetBundlePurchase_FullMethodName   = "/gym.BundleService/GetBundlePurchase"
+	BundleService_ListBundlePurchases_FullMethodName = "/gym.BundleService/ListBundlePurchases"
+)
+
+// BundleServiceClient is the client API for BundleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BundleServiceClient interface {
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*Bundle, error)
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*Bundle, error)
+	DeleteBundle(ctx context.Context, in *DeleteBundleRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error)
+	PurchaseBundle(ctx context.Context, in *PurchaseBundleRequest, opts ...grpc.CallOption) (*BundlePurchase, error)
+	GetBundlePurchase(ctx context.Context, in *GetBundlePurchaseRequest, opts ...grpc.CallOption) (*BundlePurchase, error)
+	ListBundlePurchases(ctx context.Context, in *ListBundlePurchasesRequest, opts ...grpc.CallOption) (*ListBundlePurchasesResponse, error)
+}
+
+type bundleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBundleServiceClient(cc grpc.ClientConnInterface) BundleServiceClient {
+	return &bundleServiceClient{cc}
+}
+
+func (c *bundleServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*Bundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bundle)
+	err := c.cc.Invoke(ctx, BundleService_CreateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*Bundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bundle)
+	err := c.cc.Invoke(ctx, BundleService_GetBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) DeleteBundle(ctx context.Context, in *DeleteBundleRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, BundleService_DeleteBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBundlesResponse)
+	err := c.cc.Invoke(ctx, BundleService_ListBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) PurchaseBundle(ctx context.Context, in *PurchaseBundleRequest, opts ...grpc.CallOption) (*BundlePurchase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundlePurchase)
+	err := c.cc.Invoke(ctx, BundleService_PurchaseBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) GetBundlePurchase(ctx context.Context, in *GetBundlePurchaseRequest, opts ...grpc.CallOption) (*BundlePurchase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundlePurchase)
+	err := c.cc.Invoke(ctx, BundleService_GetBundlePurchase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) ListBundlePurchases(ctx context.Context, in *ListBundlePurchasesRequest, opts ...grpc.CallOption) (*ListBundlePurchasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBundlePurchasesResponse)
+	err := c.cc.Invoke(ctx, BundleService_ListBundlePurchases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BundleServiceServer is the server API for BundleService service.
+// All implementations must embed UnimplementedBundleServiceServer
+// for forward compatibility.
+type BundleServiceServer interface {
+	CreateBundle(context.Context, *CreateBundleRequest) (*Bundle, error)
+	GetBundle(context.Context, *GetBundleRequest) (*Bundle, error)
+	DeleteBundle(context.Context, *DeleteBundleRequest) (*Empty, error)
+	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	PurchaseBundle(context.Context, *PurchaseBundleRequest) (*BundlePurchase, error)
+	GetBundlePurchase(context.Context, *GetBundlePurchaseRequest) (*BundlePurchase, error)
+	ListBundlePurchases(context.Context, *ListBundlePurchasesRequest) (*ListBundlePurchasesResponse, error)
+	mustEmbedUnimplementedBundleServiceServer()
+}
+
+// UnimplementedBundleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBundleServiceServer struct{}
+
+func (UnimplementedBundleServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*Bundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedBundleServiceServer) GetBundle(context.Context, *GetBundleRequest) (*Bundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedBundleServiceServer) DeleteBundle(context.Context, *DeleteBundleRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBundle not implemented")
+}
+func (UnimplementedBundleServiceServer) ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBundles not implemented")
+}
+func (UnimplementedBundleServiceServer) PurchaseBundle(context.Context, *PurchaseBundleRequest) (*BundlePurchase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseBundle not implemented")
+}
+func (UnimplementedBundleServiceServer) GetBundlePurchase(context.Context, *GetBundlePurchaseRequest) (*BundlePurchase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundlePurchase not implemented")
+}
+func (UnimplementedBundleServiceServer) ListBundlePurchases(context.Context, *ListBundlePurchasesRequest) (*ListBundlePurchasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBundlePurchases not implemented")
+}
+func (UnimplementedBundleServiceServer) mustEmbedUnimplementedBundleServiceServer() {}
+func (UnimplementedBundleServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeBundleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BundleServiceServer will
+// result in compilation errors.
+type UnsafeBundleServiceServer interface {
+	mustEmbedUnimplementedBundleServiceServer()
+}
+
+func RegisterBundleServiceServer(s grpc.ServiceRegistrar, srv BundleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBundleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BundleService_ServiceDesc, srv)
+}
+
+func _BundleService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_CreateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).CreateBundle(ctx, req.(*CreateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_DeleteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).DeleteBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_DeleteBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).DeleteBundle(ctx, req.(*DeleteBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_ListBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).ListBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_ListBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).ListBundles(ctx, req.(*ListBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_PurchaseBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).PurchaseBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_PurchaseBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).PurchaseBundle(ctx, req.(*PurchaseBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_GetBundlePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundlePurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).GetBundlePurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_GetBundlePurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).GetBundlePurchase(ctx, req.(*GetBundlePurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_ListBundlePurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundlePurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).ListBundlePurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_ListBundlePurchases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).ListBundlePurchases(ctx, req.(*ListBundlePurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BundleService_ServiceDesc is the grpc.ServiceDesc for BundleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BundleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.BundleService",
+	HandlerType: (*BundleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBundle",
+			Handler:    _BundleService_CreateBundle_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _BundleService_GetBundle_Handler,
+		},
+		{
+			MethodName: "DeleteBundle",
+			Handler:    _BundleService_DeleteBundle_Handler,
+		},
+		{
+			MethodName: "ListBundles",
+			Handler:    _BundleService_ListBundles_Handler,
+		},
+		{
+			MethodName: "PurchaseBundle",
+			Handler:    _BundleService_PurchaseBundle_Handler,
+		},
+		{
+			MethodName: "GetBundlePurchase",
+			Handler:    _BundleService_GetBundlePurchase_Handler,
+		},
+		{
+			MethodName: "ListBundlePurchases",
+			Handler:    _BundleService_ListBundlePurchases_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/bundle.proto",
+}
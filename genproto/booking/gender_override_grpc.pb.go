@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/gender_override.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GenderOverrideService_GrantGenderOverride_FullMethodName  = "/gym.GenderOverrideService/GrantGenderOverride"
+	GenderOverrideService_RevokeGenderOverride_FullMethodName = "/gym.GenderOverrideService/RevokeGenderOverride"
+	GenderOverrideService_ListGenderOverrides_FullMethodName  = "/gym.GenderOverrideService/ListGenderOverrides"
+	GenderOverrideService_SetMemberGender_FullMethodName      = "/gym.GenderOverrideService/SetMemberGender"
+)
+
+// GenderOverrideServiceClient is the client API for GenderOverrideService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GenderOverrideServiceClient interface {
+	GrantGenderOverride(ctx context.Context, in *GrantGenderOverrideRequest, opts ...grpc.CallOption) (*GenderOverride, error)
+	RevokeGenderOverride(ctx context.Context, in *RevokeGenderOverrideRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListGenderOverrides(ctx context.Context, in *ListGenderOverridesRequest, opts ...grpc.CallOption) (*ListGenderOverridesResponse, error)
+	SetMemberGender(ctx context.Context, in *SetMemberGenderRequest, opts ...grpc.CallOption) (*MemberGender, error)
+}
+
+type genderOverrideServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGenderOverrideServiceClient(cc grpc.ClientConnInterface) GenderOverrideServiceClient {
+	return &genderOverrideServiceClient{cc}
+}
+
+func (c *genderOverrideServiceClient) GrantGenderOverride(ctx context.Context, in *GrantGenderOverrideRequest, opts ...grpc.CallOption) (*GenderOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenderOverride)
+	err := c.cc.Invoke(ctx, GenderOverrideService_GrantGenderOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genderOverrideServiceClient) RevokeGenderOverride(ctx context.Context, in *RevokeGenderOverrideRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, GenderOverrideService_RevokeGenderOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genderOverrideServiceClient) ListGenderOverrides(ctx context.Context, in *ListGenderOverridesRequest, opts ...grpc.CallOption) (*ListGenderOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGenderOverridesResponse)
+	err := c.cc.Invoke(ctx, GenderOverrideService_ListGenderOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genderOverrideServiceClient) SetMemberGender(ctx context.Context, in *SetMemberGenderRequest, opts ...grpc.CallOption) (*MemberGender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberGender)
+	err := c.cc.Invoke(ctx, GenderOverrideService_SetMemberGender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenderOverrideServiceServer is the server API for GenderOverrideService service.
+// All implementations must embed UnimplementedGenderOverrideServiceServer
+// for forward compatibility.
+type GenderOverrideServiceServer interface {
+	GrantGenderOverride(context.Context, *GrantGenderOverrideRequest) (*GenderOverride, error)
+	RevokeGenderOverride(context.Context, *RevokeGenderOverrideRequest) (*Empty, error)
+	ListGenderOverrides(context.Context, *ListGenderOverridesRequest) (*ListGenderOverridesResponse, error)
+	SetMemberGender(context.Context, *SetMemberGenderRequest) (*MemberGender, error)
+	mustEmbedUnimplementedGenderOverrideServiceServer()
+}
+
+// UnimplementedGenderOverrideServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGenderOverrideServiceServer struct{}
+
+func (UnimplementedGenderOverrideServiceServer) GrantGenderOverride(context.Context, *GrantGenderOverrideRequest) (*GenderOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantGenderOverride not implemented")
+}
+func (UnimplementedGenderOverrideServiceServer) RevokeGenderOverride(context.Context, *RevokeGenderOverrideRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGenderOverride not implemented")
+}
+func (UnimplementedGenderOverrideServiceServer) ListGenderOverrides(context.Context, *ListGenderOverridesRequest) (*ListGenderOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGenderOverrides not implemented")
+}
+func (UnimplementedGenderOverrideServiceServer) SetMemberGender(context.Context, *SetMemberGenderRequest) (*MemberGender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberGender not implemented")
+}
+func (UnimplementedGenderOverrideServiceServer) mustEmbedUnimplementedGenderOverrideServiceServer() {}
+func (UnimplementedGenderOverrideServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeGenderOverrideServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GenderOverrideServiceServer will
+// result in compilation errors.
+type UnsafeGenderOverrideServiceServer interface {
+	mustEmbedUnimplementedGenderOverrideServiceServer()
+}
+
+func RegisterGenderOverrideServiceServer(s grpc.ServiceRegistrar, srv GenderOverrideServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGenderOverrideServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GenderOverrideService_ServiceDesc, srv)
+}
+
+func _GenderOverrideService_GrantGenderOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantGenderOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenderOverrideServiceServer).GrantGenderOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenderOverrideService_GrantGenderOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenderOverrideServiceServer).GrantGenderOverride(ctx, req.(*GrantGenderOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenderOverrideService_RevokeGenderOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGenderOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenderOverrideServiceServer).RevokeGenderOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenderOverrideService_RevokeGenderOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenderOverrideServiceServer).RevokeGenderOverride(ctx, req.(*RevokeGenderOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenderOverrideService_ListGenderOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGenderOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenderOverrideServiceServer).ListGenderOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenderOverrideService_ListGenderOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenderOverrideServiceServer).ListGenderOverrides(ctx, req.(*ListGenderOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenderOverrideService_SetMemberGender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberGenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenderOverrideServiceServer).SetMemberGender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenderOverrideService_SetMemberGender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenderOverrideServiceServer).SetMemberGender(ctx, req.(*SetMemberGenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GenderOverrideService_ServiceDesc is the grpc.ServiceDesc for GenderOverrideService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GenderOverrideService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.GenderOverrideService",
+	HandlerType: (*GenderOverrideServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantGenderOverride",
+			Handler:    _GenderOverrideService_GrantGenderOverride_Handler,
+		},
+		{
+			MethodName: "RevokeGenderOverride",
+			Handler:    _GenderOverrideService_RevokeGenderOverride_Handler,
+		},
+		{
+			MethodName: "ListGenderOverrides",
+			Handler:    _GenderOverrideService_ListGenderOverrides_Handler,
+		},
+		{
+			MethodName: "SetMemberGender",
+			Handler:    _GenderOverrideService_SetMemberGender_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/gender_override.proto",
+}
@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: protos/occupancy.proto
+
+package booking
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Occupancy is the number of members inside a sport hall right now.
+type Occupancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId        string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	Current      int32  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	MaxOccupancy int32  `protobuf:"varint,3,opt,name=max_occupancy,json=maxOccupancy,proto3" json:"max_occupancy,omitempty"` // 0 means no limit
+	UpdatedAt    string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Occupancy) Reset() {
+	*x = Occupancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_occupancy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Occupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Occupancy) ProtoMessage() {}
+
+func (x *Occupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_occupancy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Occupancy.ProtoReflect.Descriptor instead.
+func (*Occupancy) Descriptor() ([]byte, []int) {
+	return file_protos_occupancy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Occupancy) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *Occupancy) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *Occupancy) GetMaxOccupancy() int32 {
+	if x != nil {
+		return x.MaxOccupancy
+	}
+	return 0
+}
+
+func (x *Occupancy) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetOccupancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+}
+
+func (x *GetOccupancyRequest) Reset() {
+	*x = GetOccupancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_occupancy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccupancyRequest) ProtoMessage() {}
+
+func (x *GetOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_occupancy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*GetOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_protos_occupancy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOccupancyRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+type SetMaxOccupancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId        string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	MaxOccupancy int32  `protobuf:"varint,2,opt,name=max_occupancy,json=maxOccupancy,proto3" json:"max_occupancy,omitempty"` // 0 removes the limit
+}
+
+func (x *SetMaxOccupancyRequest) Reset() {
+	*x = SetMaxOccupancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_occupancy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaxOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxOccupancyRequest) ProtoMessage() {}
+
+func (x *SetMaxOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_occupancy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*SetMaxOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_protos_occupancy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetMaxOccupancyRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *SetMaxOccupancyRequest) GetMaxOccupancy() int32 {
+	if x != nil {
+		return x.MaxOccupancy
+	}
+	return 0
+}
+
+type StreamOccupancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+}
+
+func (x *StreamOccupancyRequest) Reset() {
+	*x = StreamOccupancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_occupancy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOccupancyRequest) ProtoMessage() {}
+
+func (x *StreamOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_occupancy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*StreamOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_protos_occupancy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamOccupancyRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+var File_protos_occupancy_proto protoreflect.FileDescriptor
+
+var file_protos_occupancy_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x79, 0x6d, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x09,
+	0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x22, 0x2f, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x63, 0x63, 0x75,
+	0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79,
+	0x6d, 0x49, 0x64, 0x32, 0xcb, 0x02, 0x0a, 0x10, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f,
+	0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x79, 0x6d, 0x73, 0x2f, 0x7b, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x63,
+	0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x6a, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4d, 0x61,
+	0x78, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x2e, 0x67, 0x79, 0x6d,
+	0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x63,
+	0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a,
+	0x01, 0x2a, 0x1a, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x79, 0x6d, 0x73, 0x2f, 0x7b, 0x67, 0x79,
+	0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x78, 0x2d, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x12, 0x6c, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x63, 0x63,
+	0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x79, 0x6d, 0x73, 0x2f, 0x7b, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f,
+	0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x3a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30,
+	0x01, 0x42, 0x12, 0x5a, 0x10, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protos_occupancy_proto_rawDescOnce sync.Once
+	file_protos_occupancy_proto_rawDescData = file_protos_occupancy_proto_rawDesc
+)
+
+func file_protos_occupancy_proto_rawDescGZIP() []byte {
+	file_protos_occupancy_proto_rawDescOnce.Do(func() {
+		file_protos_occupancy_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_occupancy_proto_rawDescData)
+	})
+	return file_protos_occupancy_proto_rawDescData
+}
+
+var file_protos_occupancy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_protos_occupancy_proto_goTypes = []any{
+	(*Occupancy)(nil),              // 0: gym.Occupancy
+	(*GetOccupancyRequest)(nil),    // 1: gym.GetOccupancyRequest
+	(*SetMaxOccupancyRequest)(nil), // 2: gym.SetMaxOccupancyRequest
+	(*StreamOccupancyRequest)(nil), // 3: gym.StreamOccupancyRequest
+}
+var file_protos_occupancy_proto_depIdxs = []int32{
+	1, // 0: gym.OccupancyService.GetOccupancy:input_type -> gym.GetOccupancyRequest
+	2, // 1: gym.OccupancyService.SetMaxOccupancy:input_type -> gym.SetMaxOccupancyRequest
+	3, // 2: gym.OccupancyService.StreamOccupancy:input_type -> gym.StreamOccupancyRequest
+	0, // 3: gym.OccupancyService.GetOccupancy:output_type -> gym.Occupancy
+	0, // 4: gym.OccupancyService.SetMaxOccupancy:output_type -> gym.Occupancy
+	0, // 5: gym.OccupancyService.StreamOccupancy:output_type -> gym.Occupancy
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protos_occupancy_proto_init() }
+func file_protos_occupancy_proto_init() {
+	if File_protos_occupancy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protos_occupancy_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Occupancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_occupancy_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOccupancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_occupancy_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SetMaxOccupancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_occupancy_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamOccupancyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protos_occupancy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_occupancy_proto_goTypes,
+		DependencyIndexes: file_protos_occupancy_proto_depIdxs,
+		MessageInfos:      file_protos_occupancy_proto_msgTypes,
+	}.Build()
+	File_protos_occupancy_proto = out.File
+	file_protos_occupancy_proto_rawDesc = nil
+	file_protos_occupancy_proto_goTypes = nil
+	file_protos_occupancy_proto_depIdxs = nil
+}
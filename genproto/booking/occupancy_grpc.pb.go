@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/occupancy.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OccupancyService_GetOccupancy_FullMethodName    = "/gym.OccupancyService/GetOccupancy"
+	OccupancyService_SetMaxOccupancy_FullMethodName = "/gym.OccupancyService/SetMaxOccupancy"
+	OccupancyService_StreamOccupancy_FullMethodName = "/gym.OccupancyService/StreamOccupancy"
+)
+
+// OccupancyServiceClient is the client API for OccupancyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OccupancyServiceClient interface {
+	GetOccupancy(ctx context.Context, in *GetOccupancyRequest, opts ...grpc.CallOption) (*Occupancy, error)
+	SetMaxOccupancy(ctx context.Context, in *SetMaxOccupancyRequest, opts ...grpc.CallOption) (*Occupancy, error)
+	// StreamOccupancy sends the current occupancy, then every change to it.
+	StreamOccupancy(ctx context.Context, in *StreamOccupancyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Occupancy], error)
+}
+
+type occupancyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOccupancyServiceClient(cc grpc.ClientConnInterface) OccupancyServiceClient {
+	return &occupancyServiceClient{cc}
+}
+
+func (c *occupancyServiceClient) GetOccupancy(ctx context.Context, in *GetOccupancyRequest, opts ...grpc.CallOption) (*Occupancy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Occupancy)
+	err := c.cc.Invoke(ctx, OccupancyService_GetOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *occupancyServiceClient) SetMaxOccupancy(ctx context.Context, in *SetMaxOccupancyRequest, opts ...grpc.CallOption) (*Occupancy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Occupancy)
+	err := c.cc.Invoke(ctx, OccupancyService_SetMaxOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *occupancyServiceClient) StreamOccupancy(ctx context.Context, in *StreamOccupancyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Occupancy], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OccupancyService_ServiceDesc.Streams[0], OccupancyService_StreamOccupancy_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamOccupancyRequest, Occupancy]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OccupancyService_StreamOccupancyClient = grpc.ServerStreamingClient[Occupancy]
+
+// OccupancyServiceServer is the server API for OccupancyService service.
+// All implementations must embed UnimplementedOccupancyServiceServer
+// for forward compatibility.
+type OccupancyServiceServer interface {
+	GetOccupancy(context.Context, *GetOccupancyRequest) (*Occupancy, error)
+	SetMaxOccupancy(context.Context, *SetMaxOccupancyRequest) (*Occupancy, error)
+	// StreamOccupancy sends the current occupancy, then every change to it.
+	StreamOccupancy(*StreamOccupancyRequest, grpc.ServerStreamingServer[Occupancy]) error
+	mustEmbedUnimplementedOccupancyServiceServer()
+}
+
+// UnimplementedOccupancyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOccupancyServiceServer struct{}
+
+func (UnimplementedOccupancyServiceServer) GetOccupancy(context.Context, *GetOccupancyRequest) (*Occupancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOccupancy not implemented")
+}
+func (UnimplementedOccupancyServiceServer) SetMaxOccupancy(context.Context, *SetMaxOccupancyRequest) (*Occupancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxOccupancy not implemented")
+}
+func (UnimplementedOccupancyServiceServer) StreamOccupancy(*StreamOccupancyRequest, grpc.ServerStreamingServer[Occupancy]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOccupancy not implemented")
+}
+func (UnimplementedOccupancyServiceServer) mustEmbedUnimplementedOccupancyServiceServer() {}
+func (UnimplementedOccupancyServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeOccupancyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OccupancyServiceServer will
+// result in compilation errors.
+type UnsafeOccupancyServiceServer interface {
+	mustEmbedUnimplementedOccupancyServiceServer()
+}
+
+func RegisterOccupancyServiceServer(s grpc.ServiceRegistrar, srv OccupancyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOccupancyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OccupancyService_ServiceDesc, srv)
+}
+
+func _OccupancyService_GetOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OccupancyServiceServer).GetOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OccupancyService_GetOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OccupancyServiceServer).GetOccupancy(ctx, req.(*GetOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OccupancyService_SetMaxOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OccupancyServiceServer).SetMaxOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OccupancyService_SetMaxOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OccupancyServiceServer).SetMaxOccupancy(ctx, req.(*SetMaxOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OccupancyService_StreamOccupancy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOccupancyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OccupancyServiceServer).StreamOccupancy(m, &grpc.GenericServerStream[StreamOccupancyRequest, Occupancy]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OccupancyService_StreamOccupancyServer = grpc.ServerStreamingServer[Occupancy]
+
+// OccupancyService_ServiceDesc is the grpc.ServiceDesc for OccupancyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OccupancyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.OccupancyService",
+	HandlerType: (*OccupancyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOccupancy",
+			Handler:    _OccupancyService_GetOccupancy_Handler,
+		},
+		{
+			MethodName: "SetMaxOccupancy",
+			Handler:    _OccupancyService_SetMaxOccupancy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOccupancy",
+			Handler:       _OccupancyService_StreamOccupancy_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protos/occupancy.proto",
+}
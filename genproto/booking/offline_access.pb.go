@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: protos/offline_access.proto
+
+package booking
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OfflineCredential lets a turnstile controller admit a member while it cannot
+// reach the service. One member of a shared booking is one credential.
+type OfflineCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BookingId   string        `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	StartDate   string        `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     string        `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	VisitsLeft  int32         `protobuf:"varint,5,opt,name=visits_left,json=visitsLeft,proto3" json:"visits_left,omitempty"`   // -1 means unlimited
+	TimeWindows []*TimeWindow `protobuf:"bytes,6,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows,omitempty"` // empty means any time, in the hall's timezone
+}
+
+func (x *OfflineCredential) Reset() {
+	*x = OfflineCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflineCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineCredential) ProtoMessage() {}
+
+func (x *OfflineCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineCredential.ProtoReflect.Descriptor instead.
+func (*OfflineCredential) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OfflineCredential) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OfflineCredential) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *OfflineCredential) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *OfflineCredential) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *OfflineCredential) GetVisitsLeft() int32 {
+	if x != nil {
+		return x.VisitsLeft
+	}
+	return 0
+}
+
+func (x *OfflineCredential) GetTimeWindows() []*TimeWindow {
+	if x != nil {
+		return x.TimeWindows
+	}
+	return nil
+}
+
+// OfflineAccessSnapshot is the signed list of credentials valid at one gym.
+// A full snapshot replaces the controller's list; a delta applies on top of
+// base_version.
+type OfflineAccessSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId       string               `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	Version     int64                `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	BaseVersion int64                `protobuf:"varint,3,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"` // 0 for a full snapshot
+	Credentials []*OfflineCredential `protobuf:"bytes,4,rep,name=credentials,proto3" json:"credentials,omitempty"`                     // all credentials, or those added or changed since base_version
+	Removed     []*OfflineCredential `protobuf:"bytes,5,rep,name=removed,proto3" json:"removed,omitempty"`                             // only user_id and booking_id are set
+	Timezone    string               `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	GeneratedAt string               `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Signature   string               `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"` // base64url HMAC-SHA256 of the snapshot serialized deterministically without this field
+}
+
+func (x *OfflineAccessSnapshot) Reset() {
+	*x = OfflineAccessSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflineAccessSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineAccessSnapshot) ProtoMessage() {}
+
+func (x *OfflineAccessSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineAccessSnapshot.ProtoReflect.Descriptor instead.
+func (*OfflineAccessSnapshot) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OfflineAccessSnapshot) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *OfflineAccessSnapshot) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OfflineAccessSnapshot) GetBaseVersion() int64 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *OfflineAccessSnapshot) GetCredentials() []*OfflineCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *OfflineAccessSnapshot) GetRemoved() []*OfflineCredential {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *OfflineAccessSnapshot) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *OfflineAccessSnapshot) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *OfflineAccessSnapshot) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ExportOfflineAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId        string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	SinceVersion int64  `protobuf:"varint,2,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"` // 0 exports a full snapshot
+}
+
+func (x *ExportOfflineAccessRequest) Reset() {
+	*x = ExportOfflineAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOfflineAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOfflineAccessRequest) ProtoMessage() {}
+
+func (x *ExportOfflineAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOfflineAccessRequest.ProtoReflect.Descriptor instead.
+func (*ExportOfflineAccessRequest) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportOfflineAccessRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *ExportOfflineAccessRequest) GetSinceVersion() int64 {
+	if x != nil {
+		return x.SinceVersion
+	}
+	return 0
+}
+
+// OfflineEntry is one scan a controller logged while offline.
+type OfflineEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientEntryId string `protobuf:"bytes,1,opt,name=client_entry_id,json=clientEntryId,proto3" json:"client_entry_id,omitempty"` // unique per device, makes uploads idempotent
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BookingId     string `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Direction     string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"` // "entry" or "exit"
+	ScannedAt     string `protobuf:"bytes,5,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+}
+
+func (x *OfflineEntry) Reset() {
+	*x = OfflineEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineEntry) ProtoMessage() {}
+
+func (x *OfflineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineEntry.ProtoReflect.Descriptor instead.
+func (*OfflineEntry) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OfflineEntry) GetClientEntryId() string {
+	if x != nil {
+		return x.ClientEntryId
+	}
+	return ""
+}
+
+func (x *OfflineEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OfflineEntry) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *OfflineEntry) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *OfflineEntry) GetScannedAt() string {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return ""
+}
+
+type ImportOfflineEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId     string          `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	DeviceId  string          `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Entries   []*OfflineEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Signature string          `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"` // base64url HMAC-SHA256 with the device's key of the request serialized deterministically without this field
+}
+
+func (x *ImportOfflineEntriesRequest) Reset() {
+	*x = ImportOfflineEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOfflineEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOfflineEntriesRequest) ProtoMessage() {}
+
+func (x *ImportOfflineEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOfflineEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ImportOfflineEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportOfflineEntriesRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *ImportOfflineEntriesRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ImportOfflineEntriesRequest) GetEntries() []*OfflineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ImportOfflineEntriesRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ImportOfflineEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported   int32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Duplicates int32 `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"`                // already uploaded before
+	Rejected   int32 `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`                    // unknown booking or not at this gym
+	OverLimit  int32 `protobuf:"varint,4,opt,name=over_limit,json=overLimit,proto3" json:"over_limit,omitempty"` // imported entries that exceeded the visits left
+}
+
+func (x *ImportOfflineEntriesResponse) Reset() {
+	*x = ImportOfflineEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOfflineEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOfflineEntriesResponse) ProtoMessage() {}
+
+func (x *ImportOfflineEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOfflineEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ImportOfflineEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportOfflineEntriesResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportOfflineEntriesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportOfflineEntriesResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ImportOfflineEntriesResponse) GetOverLimit() int32 {
+	if x != nil {
+		return x.OverLimit
+	}
+	return 0
+}
+
+// OfflineDevice is a controller allowed to upload offline scans for a gym.
+type OfflineDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId  string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	GymId     string `protobuf:"bytes,2,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"` // signs the device's uploads; only returned when it is registered
+	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *OfflineDevice) Reset() {
+	*x = OfflineDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflineDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineDevice) ProtoMessage() {}
+
+func (x *OfflineDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineDevice.ProtoReflect.Descriptor instead.
+func (*OfflineDevice) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OfflineDevice) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *OfflineDevice) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *OfflineDevice) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *OfflineDevice) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// RegisterOfflineDeviceRequest registers a device, or gives a registered
+// device of the same gym a new key.
+type RegisterOfflineDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId    string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (x *RegisterOfflineDeviceRequest) Reset() {
+	*x = RegisterOfflineDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterOfflineDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterOfflineDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterOfflineDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterOfflineDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterOfflineDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegisterOfflineDeviceRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *RegisterOfflineDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type RevokeOfflineDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GymId    string `protobuf:"bytes,1,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (x *RevokeOfflineDeviceRequest) Reset() {
+	*x = RevokeOfflineDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_offline_access_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeOfflineDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOfflineDeviceRequest) ProtoMessage() {}
+
+func (x *RevokeOfflineDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_offline_access_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOfflineDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOfflineDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_protos_offline_access_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeOfflineDeviceRequest) GetGymId() string {
+	if x != nil {
+		return x.GymId
+	}
+	return ""
+}
+
+func (x *RevokeOfflineDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+var File_protos_offline_access_proto protoreflect.FileDescriptor
+
+var file_protos_offline_access_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67,
+	0x79, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xda, 0x01, 0x0a, 0x11, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x73,
+	0x69, 0x74, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0xb4,
+	0x02, 0x0a, 0x15, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x61, 0x73, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x58, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xab, 0x01, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9c, 0x01,
+	0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x79, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x95, 0x01, 0x0a,
+	0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x74, 0x0a, 0x0d, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x1c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x50,
+	0x0a, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79,
+	0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x32, 0x99, 0x04, 0x0a, 0x14, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x79, 0x6d, 0x73, 0x2f,
+	0x7b, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x2d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x20, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a,
+	0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x79, 0x6d, 0x73, 0x2f, 0x7b, 0x67, 0x79, 0x6d, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x2d, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x67,
+	0x79, 0x6d, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x79, 0x6d, 0x73, 0x2f, 0x7b, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x79, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x79, 0x6d, 0x73, 0x2f, 0x7b, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0x12, 0x5a, 0x10,
+	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protos_offline_access_proto_rawDescOnce sync.Once
+	file_protos_offline_access_proto_rawDescData = file_protos_offline_access_proto_rawDesc
+)
+
+func file_protos_offline_access_proto_rawDescGZIP() []byte {
+	file_protos_offline_access_proto_rawDescOnce.Do(func() {
+		file_protos_offline_access_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_offline_access_proto_rawDescData)
+	})
+	return file_protos_offline_access_proto_rawDescData
+}
+
+var file_protos_offline_access_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_protos_offline_access_proto_goTypes = []any{
+	(*OfflineCredential)(nil),            // 0: gym.OfflineCredential
+	(*OfflineAccessSnapshot)(nil),        // 1: gym.OfflineAccessSnapshot
+	(*ExportOfflineAccessRequest)(nil),   // 2: gym.ExportOfflineAccessRequest
+	(*OfflineEntry)(nil),                 // 3: gym.OfflineEntry
+	(*ImportOfflineEntriesRequest)(nil),  // 4: gym.ImportOfflineEntriesRequest
+	(*ImportOfflineEntriesResponse)(nil), // 5: gym.ImportOfflineEntriesResponse
+	(*OfflineDevice)(nil),                // 6: gym.OfflineDevice
+	(*RegisterOfflineDeviceRequest)(nil), // 7: gym.RegisterOfflineDeviceRequest
+	(*RevokeOfflineDeviceRequest)(nil),   // 8: gym.RevokeOfflineDeviceRequest
+	(*TimeWindow)(nil),                   // 9: gym.TimeWindow
+	(*Empty)(nil),                        // 10: gym.Empty
+}
+var file_protos_offline_access_proto_depIdxs = []int32{
+	9,  // 0: gym.OfflineCredential.time_windows:type_name -> gym.TimeWindow
+	0,  // 1: gym.OfflineAccessSnapshot.credentials:type_name -> gym.OfflineCredential
+	0,  // 2: gym.OfflineAccessSnapshot.removed:type_name -> gym.OfflineCredential
+	3,  // 3: gym.ImportOfflineEntriesRequest.entries:type_name -> gym.OfflineEntry
+	2,  // 4: gym.OfflineAccessService.ExportOfflineAccess:input_type -> gym.ExportOfflineAccessRequest
+	4,  // 5: gym.OfflineAccessService.ImportOfflineEntries:input_type -> gym.ImportOfflineEntriesRequest
+	7,  // 6: gym.OfflineAccessService.RegisterOfflineDevice:input_type -> gym.RegisterOfflineDeviceRequest
+	8,  // 7: gym.OfflineAccessService.RevokeOfflineDevice:input_type -> gym.RevokeOfflineDeviceRequest
+	1,  // 8: gym.OfflineAccessService.ExportOfflineAccess:output_type -> gym.OfflineAccessSnapshot
+	5,  // 9: gym.OfflineAccessService.ImportOfflineEntries:output_type -> gym.ImportOfflineEntriesResponse
+	6,  // 10: gym.OfflineAccessService.RegisterOfflineDevice:output_type -> gym.OfflineDevice
+	10, // 11: gym.OfflineAccessService.RevokeOfflineDevice:output_type -> gym.Empty
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_protos_offline_access_proto_init() }
+func file_protos_offline_access_proto_init() {
+	if File_protos_offline_access_proto != nil {
+		return
+	}
+	file_protos_booking_proto_init()
+	file_protos_subscribtion_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protos_offline_access_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*OfflineCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*OfflineAccessSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportOfflineAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*OfflineEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportOfflineEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportOfflineEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*OfflineDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterOfflineDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_offline_access_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeOfflineDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protos_offline_access_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_offline_access_proto_goTypes,
+		DependencyIndexes: file_protos_offline_access_proto_depIdxs,
+		MessageInfos:      file_protos_offline_access_proto_msgTypes,
+	}.Build()
+	File_protos_offline_access_proto = out.File
+	file_protos_offline_access_proto_rawDesc = nil
+	file_protos_offline_access_proto_goTypes = nil
+	file_protos_offline_access_proto_depIdxs = nil
+}
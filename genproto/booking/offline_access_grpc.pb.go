@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/offline_access.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OfflineAccessService_ExportOfflineAccess_FullMethodName   = "/gym.OfflineAccessService/ExportOfflineAccess"
+	OfflineAccessService_ImportOfflineEntries_FullMethodName  = "/gym.OfflineAccessService/ImportOfflineEntries"
+	OfflineAccessService_RegisterOfflineDevice_FullMethodName = "/gym.OfflineAccessService/RegisterOfflineDevice"
+	OfflineAccessService_RevokeOfflineDevice_FullMethodName   = "/gym.OfflineAccessService/RevokeOfflineDevice"
+)
+
+// OfflineAccessServiceClient is the client API for OfflineAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OfflineAccessServiceClient interface {
+	ExportOfflineAccess(ctx context.Context, in *ExportOfflineAccessRequest, opts ...grpc.CallOption) (*OfflineAccessSnapshot, error)
+	ImportOfflineEntries(ctx context.Context, in *ImportOfflineEntriesRequest, opts ...grpc.CallOption) (*ImportOfflineEntriesResponse, error)
+	RegisterOfflineDevice(ctx context.Context, in *RegisterOfflineDeviceRequest, opts ...grpc.CallOption) (*OfflineDevice, error)
+	RevokeOfflineDevice(ctx context.Context, in *RevokeOfflineDeviceRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type offlineAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOfflineAccessServiceClient(cc grpc.ClientConnInterface) OfflineAccessServiceClient {
+	return &offlineAccessServiceClient{cc}
+}
+
+func (c *offlineAccessServiceClient) ExportOfflineAccess(ctx context.Context, in *ExportOfflineAccessRequest, opts ...grpc.CallOption) (*OfflineAccessSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfflineAccessSnapshot)
+	err := c.cc.Invoke(ctx, OfflineAccessService_ExportOfflineAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offlineAccessServiceClient) ImportOfflineEntries(ctx context.Context, in *ImportOfflineEntriesRequest, opts ...grpc.CallOption) (*ImportOfflineEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportOfflineEntriesResponse)
+	err := c.cc.Invoke(ctx, OfflineAccessService_ImportOfflineEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offlineAccessServiceClient) RegisterOfflineDevice(ctx context.Context, in *RegisterOfflineDeviceRequest, opts ...grpc.CallOption) (*OfflineDevice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfflineDevice)
+	err := c.cc.Invoke(ctx, OfflineAccessService_RegisterOfflineDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *offlineAccessServiceClient) RevokeOfflineDevice(ctx context.Context, in *RevokeOfflineDeviceRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, OfflineAccessService_RevokeOfflineDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OfflineAccessServiceServer is the server API for OfflineAccessService service.
+// All implementations must embed UnimplementedOfflineAccessServiceServer
+// for forward compatibility.
+type OfflineAccessServiceServer interface {
+	ExportOfflineAccess(context.Context, *ExportOfflineAccessRequest) (*OfflineAccessSnapshot, error)
+	ImportOfflineEntries(context.Context, *ImportOfflineEntriesRequest) (*ImportOfflineEntriesResponse, error)
+	RegisterOfflineDevice(context.Context, *RegisterOfflineDeviceRequest) (*OfflineDevice, error)
+	RevokeOfflineDevice(context.Context, *RevokeOfflineDeviceRequest) (*Empty, error)
+	mustEmbedUnimplementedOfflineAccessServiceServer()
+}
+
+// UnimplementedOfflineAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOfflineAccessServiceServer struct{}
+
+func (UnimplementedOfflineAccessServiceServer) ExportOfflineAccess(context.Context, *ExportOfflineAccessRequest) (*OfflineAccessSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOfflineAccess not implemented")
+}
+func (UnimplementedOfflineAccessServiceServer) ImportOfflineEntries(context.Context, *ImportOfflineEntriesRequest) (*ImportOfflineEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOfflineEntries not implemented")
+}
+func (UnimplementedOfflineAccessServiceServer) RegisterOfflineDevice(context.Context, *RegisterOfflineDeviceRequest) (*OfflineDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOfflineDevice not implemented")
+}
+func (UnimplementedOfflineAccessServiceServer) RevokeOfflineDevice(context.Context, *RevokeOfflineDeviceRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOfflineDevice not implemented")
+}
+func (UnimplementedOfflineAccessServiceServer) mustEmbedUnimplementedOfflineAccessServiceServer() {}
+func (UnimplementedOfflineAccessServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeOfflineAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OfflineAccessServiceServer will
+// result in compilation errors.
+type UnsafeOfflineAccessServiceServer interface {
+	mustEmbedUnimplementedOfflineAccessServiceServer()
+}
+
+func RegisterOfflineAccessServiceServer(s grpc.ServiceRegistrar, srv OfflineAccessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOfflineAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OfflineAccessService_ServiceDesc, srv)
+}
+
+func _OfflineAccessService_ExportOfflineAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOfflineAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfflineAccessServiceServer).ExportOfflineAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OfflineAccessService_ExportOfflineAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfflineAccessServiceServer).ExportOfflineAccess(ctx, req.(*ExportOfflineAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfflineAccessService_ImportOfflineEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOfflineEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfflineAccessServiceServer).ImportOfflineEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OfflineAccessService_ImportOfflineEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfflineAccessServiceServer).ImportOfflineEntries(ctx, req.(*ImportOfflineEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfflineAccessService_RegisterOfflineDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOfflineDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfflineAccessServiceServer).RegisterOfflineDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OfflineAccessService_RegisterOfflineDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfflineAccessServiceServer).RegisterOfflineDevice(ctx, req.(*RegisterOfflineDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OfflineAccessService_RevokeOfflineDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOfflineDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfflineAccessServiceServer).RevokeOfflineDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OfflineAccessService_RevokeOfflineDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfflineAccessServiceServer).RevokeOfflineDevice(ctx, req.(*RevokeOfflineDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OfflineAccessService_ServiceDesc is the grpc.ServiceDesc for OfflineAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OfflineAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.OfflineAccessService",
+	HandlerType: (*OfflineAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportOfflineAccess",
+			Handler:    _OfflineAccessService_ExportOfflineAccess_Handler,
+		},
+		{
+			MethodName: "ImportOfflineEntries",
+			Handler:    _OfflineAccessService_ImportOfflineEntries_Handler,
+		},
+		{
+			MethodName: "RegisterOfflineDevice",
+			Handler:    _OfflineAccessService_RegisterOfflineDevice_Handler,
+		},
+		{
+			MethodName: "RevokeOfflineDevice",
+			Handler:    _OfflineAccessService_RevokeOfflineDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/offline_access.proto",
+}
@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: protos/pass.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PassService_IssuePass_FullMethodName                = "/gym.PassService/IssuePass"
+	PassService_GetPass_FullMethodName                  = "/gym.PassService/GetPass"
+	PassService_ListPasses_FullMethodName               = "/gym.PassService/ListPasses"
+	PassService_RevokePass_FullMethodName               = "/gym.PassService/RevokePass"
+	PassService_GetTrialConversionReport_FullMethodName = "/gym.PassService/GetTrialConversionReport"
+)
+
+// PassServiceClient is the client API for PassService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PassServiceClient interface {
+	IssuePass(ctx context.Context, in *IssuePassRequest, opts ...grpc.CallOption) (*Pass, error)
+	GetPass(ctx context.Context, in *GetPassRequest, opts ...grpc.CallOption) (*Pass, error)
+	ListPasses(ctx context.Context, in *ListPassesRequest, opts ...grpc.CallOption) (*ListPassesResponse, error)
+	RevokePass(ctx context.Context, in *RevokePassRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetTrialConversionReport(ctx context.Context, in *TrialConversionReportRequest, opts ...grpc.CallOption) (*TrialConversionReport, error)
+}
+
+type passServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPassServiceClient(cc grpc.ClientConnInterface) PassServiceClient {
+	return &passServiceClient{cc}
+}
+
+func (c *passServiceClient) IssuePass(ctx context.Context, in *IssuePassRequest, opts ...grpc.CallOption) (*Pass, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pass)
+	err := c.cc.Invoke(ctx, PassService_IssuePass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passServiceClient) GetPass(ctx context.Context, in *GetPassRequest, opts ...grpc.CallOption) (*Pass, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pass)
+	err := c.cc.Invoke(ctx, PassService_GetPass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passServiceClient) ListPasses(ctx context.Context, in *ListPassesRequest, opts ...grpc.CallOption) (*ListPassesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPassesResponse)
+	err := c.cc.Invoke(ctx, PassService_ListPasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passServiceClient) RevokePass(ctx context.Context, in *RevokePassRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PassService_RevokePass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passServiceClient) GetTrialConversionReport(ctx context.Context, in *TrialConversionReportRequest, opts ...grpc.CallOption) (*TrialConversionReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrialConversionReport)
+	err := c.cc.Invoke(ctx, PassService_GetTrialConversionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PassServiceServer is the server API for PassService service.
+// All implementations must embed UnimplementedPassServiceServer
+// for forward compatibility.
+type PassServiceServer interface {
+	IssuePass(context.Context, *IssuePassRequest) (*Pass, error)
+	GetPass(context.Context, *GetPassRequest) (*Pass, error)
+	ListPasses(context.Context, *ListPassesRequest) (*ListPassesResponse, error)
+	RevokePass(context.Context, *RevokePassRequest) (*Empty, error)
+	GetTrialConversionReport(context.Context, *TrialConversionReportRequest) (*TrialConversionReport, error)
+	mustEmbedUnimplementedPassServiceServer()
+}
+
+// UnimplementedPassServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPassServiceServer struct{}
+
+func (UnimplementedPassServiceServer) IssuePass(context.Context, *IssuePassRequest) (*Pass, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuePass not implemented")
+}
+func (UnimplementedPassServiceServer) GetPass(context.Context, *GetPassRequest) (*Pass, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPass not implemented")
+}
+func (UnimplementedPassServiceServer) ListPasses(context.Context, *ListPassesRequest) (*ListPassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPasses not implemented")
+}
+func (UnimplementedPassServiceServer) RevokePass(context.Context, *RevokePassRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePass not implemented")
+}
+func (UnimplementedPassServiceServer) GetTrialConversionReport(context.Context, *TrialConversionReportRequest) (*TrialConversionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialConversionReport not implemented")
+}
+func (UnimplementedPassServiceServer) mustEmbedUnimplementedPassServiceServer() {}
+func (UnimplementedPassServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafePassServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PassServiceServer will
+// result in compilation errors.
+type UnsafePassServiceServer interface {
+	mustEmbedUnimplementedPassServiceServer()
+}
+
+func RegisterPassServiceServer(s grpc.ServiceRegistrar, srv PassServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPassServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PassService_ServiceDesc, srv)
+}
+
+func _PassService_IssuePass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuePassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassServiceServer).IssuePass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassService_IssuePass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassServiceServer).IssuePass(ctx, req.(*IssuePassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassService_GetPass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassServiceServer).GetPass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassService_GetPass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassServiceServer).GetPass(ctx, req.(*GetPassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassService_ListPasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassServiceServer).ListPasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassService_ListPasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassServiceServer).ListPasses(ctx, req.(*ListPassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassService_RevokePass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassServiceServer).RevokePass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassService_RevokePass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassServiceServer).RevokePass(ctx, req.(*RevokePassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassService_GetTrialConversionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrialConversionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassServiceServer).GetTrialConversionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassService_GetTrialConversionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassServiceServer).GetTrialConversionReport(ctx, req.(*TrialConversionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PassService_ServiceDesc is the grpc.ServiceDesc for PassService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PassService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gym.PassService",
+	HandlerType: (*PassServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssuePass",
+			Handler:    _PassService_IssuePass_Handler,
+		},
+		{
+			MethodName: "GetPass",
+			Handler:    _PassService_GetPass_Handler,
+		},
+		{
+			MethodName: "ListPasses",
+			Handler:    _PassService_ListPasses_Handler,
+		},
+		{
+			MethodName: "RevokePass",
+			Handler:    _PassService_RevokePass_Handler,
+		},
+		{
+			MethodName: "GetTrialConversionReport",
+			Handler:    _PassService_GetTrialConversionReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/pass.proto",
+}
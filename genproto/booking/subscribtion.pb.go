@@ -7,6 +7,7 @@
 package booking
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,16 +26,18 @@ type SubscriptionPersonal struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GymId       string `protobuf:"bytes,2,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
-	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price       int32  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Duration    int32  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
-	Count       int32  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
-	CreatedAt   string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeletedAt   int64  `protobuf:"varint,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Id          string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GymId       string        `protobuf:"bytes,2,opt,name=gym_id,json=gymId,proto3" json:"gym_id,omitempty"`
+	Type        string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description string        `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       int32         `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Duration    int32         `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Count       int32         `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt   string        `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string        `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt   int64         `protobuf:"varint,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version     int32         `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	TimeWindows []*TimeWindow `protobuf:"bytes,12,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows,omitempty"` // empty means the plan is valid at any time
 }
 
 func (x *SubscriptionPersonal) Reset() {
@@ -139,6 +142,85 @@ func (x *SubscriptionPersonal) GetDeletedAt() int64 {
 	return 0
 }
 
+func (x *SubscriptionPersonal) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SubscriptionPersonal) GetTimeWindows() []*TimeWindow {
+	if x != nil {
+		return x.TimeWindows
+	}
+	return nil
+}
+
+// TimeWindow is a weekly slot in which a plan grants access, in the gym's
+// local time.
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weekday   int32  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`                     // 0 = Sunday ... 6 = Saturday
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // "HH:MM"
+	EndTime   string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // "HH:MM", exclusive
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_subscribtion_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_subscribtion_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeWindow) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *TimeWindow) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 type SubscriptionGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -157,12 +239,13 @@ type SubscriptionGroup struct {
 	CreatedAt   string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   string `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt   int64  `protobuf:"varint,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version     int32  `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SubscriptionGroup) Reset() {
 	*x = SubscriptionGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[1]
+		mi := &file_protos_subscribtion_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -175,7 +258,7 @@ func (x *SubscriptionGroup) String() string {
 func (*SubscriptionGroup) ProtoMessage() {}
 
 func (x *SubscriptionGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[1]
+	mi := &file_protos_subscribtion_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +271,7 @@ func (x *SubscriptionGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionGroup.ProtoReflect.Descriptor instead.
 func (*SubscriptionGroup) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{1}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SubscriptionGroup) GetId() string {
@@ -282,6 +365,13 @@ func (x *SubscriptionGroup) GetDeletedAt() int64 {
 	return 0
 }
 
+func (x *SubscriptionGroup) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type SubscriptionCoach struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -297,12 +387,13 @@ type SubscriptionCoach struct {
 	CreatedAt   string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt   int64  `protobuf:"varint,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version     int32  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *SubscriptionCoach) Reset() {
 	*x = SubscriptionCoach{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[2]
+		mi := &file_protos_subscribtion_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -315,7 +406,7 @@ func (x *SubscriptionCoach) String() string {
 func (*SubscriptionCoach) ProtoMessage() {}
 
 func (x *SubscriptionCoach) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[2]
+	mi := &file_protos_subscribtion_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +419,7 @@ func (x *SubscriptionCoach) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionCoach.ProtoReflect.Descriptor instead.
 func (*SubscriptionCoach) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{2}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SubscriptionCoach) GetId() string {
@@ -401,6 +492,196 @@ func (x *SubscriptionCoach) GetDeletedAt() int64 {
 	return 0
 }
 
+func (x *SubscriptionCoach) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// SubscriptionVersion is an immutable snapshot of a plan's pricing terms.
+// Bookings pin the version they were sold under.
+type SubscriptionVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Version        int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Price          int32  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Duration       int32  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Count          int32  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt      string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SubscriptionVersion) Reset() {
+	*x = SubscriptionVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_subscribtion_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionVersion) ProtoMessage() {}
+
+func (x *SubscriptionVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_subscribtion_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionVersion.ProtoReflect.Descriptor instead.
+func (*SubscriptionVersion) Descriptor() ([]byte, []int) {
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscriptionVersion) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *SubscriptionVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SubscriptionVersion) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SubscriptionVersion) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SubscriptionVersion) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SubscriptionVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListSubscriptionVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (x *ListSubscriptionVersionsRequest) Reset() {
+	*x = ListSubscriptionVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_subscribtion_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionVersionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_subscribtion_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSubscriptionVersionsRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type ListSubscriptionVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*SubscriptionVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListSubscriptionVersionsResponse) Reset() {
+	*x = ListSubscriptionVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_subscribtion_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionVersionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_subscribtion_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSubscriptionVersionsResponse) GetVersions() []*SubscriptionVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
 type CreateSubscriptionPersonalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -412,7 +693,7 @@ type CreateSubscriptionPersonalRequest struct {
 func (x *CreateSubscriptionPersonalRequest) Reset() {
 	*x = CreateSubscriptionPersonalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[3]
+		mi := &file_protos_subscribtion_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +706,7 @@ func (x *CreateSubscriptionPersonalRequest) String() string {
 func (*CreateSubscriptionPersonalRequest) ProtoMessage() {}
 
 func (x *CreateSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[3]
+	mi := &file_protos_subscribtion_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +719,7 @@ func (x *CreateSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateSubscriptionPersonalRequest.ProtoReflect.Descriptor instead.
 func (*CreateSubscriptionPersonalRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{3}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateSubscriptionPersonalRequest) GetSubscriptionPersonal() *SubscriptionPersonal {
@@ -459,7 +740,7 @@ type GetSubscriptionPersonalRequest struct {
 func (x *GetSubscriptionPersonalRequest) Reset() {
 	*x = GetSubscriptionPersonalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[4]
+		mi := &file_protos_subscribtion_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -472,7 +753,7 @@ func (x *GetSubscriptionPersonalRequest) String() string {
 func (*GetSubscriptionPersonalRequest) ProtoMessage() {}
 
 func (x *GetSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[4]
+	mi := &file_protos_subscribtion_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +766,7 @@ func (x *GetSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionPersonalRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionPersonalRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{4}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSubscriptionPersonalRequest) GetId() string {
@@ -501,12 +782,13 @@ type UpdateSubscriptionPersonalRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	SubscriptionPersonal *SubscriptionPersonal `protobuf:"bytes,1,opt,name=subscription_personal,json=subscriptionPersonal,proto3" json:"subscription_personal,omitempty"`
+	SetTimeWindows       bool                  `protobuf:"varint,2,opt,name=set_time_windows,json=setTimeWindows,proto3" json:"set_time_windows,omitempty"` // replace the time windows with subscription_personal.time_windows; when false they are kept
 }
 
 func (x *UpdateSubscriptionPersonalRequest) Reset() {
 	*x = UpdateSubscriptionPersonalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[5]
+		mi := &file_protos_subscribtion_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +801,7 @@ func (x *UpdateSubscriptionPersonalRequest) String() string {
 func (*UpdateSubscriptionPersonalRequest) ProtoMessage() {}
 
 func (x *UpdateSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[5]
+	mi := &file_protos_subscribtion_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +814,7 @@ func (x *UpdateSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateSubscriptionPersonalRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSubscriptionPersonalRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{5}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateSubscriptionPersonalRequest) GetSubscriptionPersonal() *SubscriptionPersonal {
@@ -542,6 +824,13 @@ func (x *UpdateSubscriptionPersonalRequest) GetSubscriptionPersonal() *Subscript
 	return nil
 }
 
+func (x *UpdateSubscriptionPersonalRequest) GetSetTimeWindows() bool {
+	if x != nil {
+		return x.SetTimeWindows
+	}
+	return false
+}
+
 type DeleteSubscriptionPersonalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -553,7 +842,7 @@ type DeleteSubscriptionPersonalRequest struct {
 func (x *DeleteSubscriptionPersonalRequest) Reset() {
 	*x = DeleteSubscriptionPersonalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[6]
+		mi := &file_protos_subscribtion_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +855,7 @@ func (x *DeleteSubscriptionPersonalRequest) String() string {
 func (*DeleteSubscriptionPersonalRequest) ProtoMessage() {}
 
 func (x *DeleteSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[6]
+	mi := &file_protos_subscribtion_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +868,7 @@ func (x *DeleteSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DeleteSubscriptionPersonalRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSubscriptionPersonalRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{6}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteSubscriptionPersonalRequest) GetId() string {
@@ -600,7 +889,7 @@ type ListSubscriptionPersonalRequest struct {
 func (x *ListSubscriptionPersonalRequest) Reset() {
 	*x = ListSubscriptionPersonalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[7]
+		mi := &file_protos_subscribtion_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -613,7 +902,7 @@ func (x *ListSubscriptionPersonalRequest) String() string {
 func (*ListSubscriptionPersonalRequest) ProtoMessage() {}
 
 func (x *ListSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[7]
+	mi := &file_protos_subscribtion_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +915,7 @@ func (x *ListSubscriptionPersonalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionPersonalRequest.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionPersonalRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{7}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListSubscriptionPersonalRequest) GetGymId() string {
@@ -647,7 +936,7 @@ type ListSubscriptionPersonalResponse struct {
 func (x *ListSubscriptionPersonalResponse) Reset() {
 	*x = ListSubscriptionPersonalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[8]
+		mi := &file_protos_subscribtion_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -660,7 +949,7 @@ func (x *ListSubscriptionPersonalResponse) String() string {
 func (*ListSubscriptionPersonalResponse) ProtoMessage() {}
 
 func (x *ListSubscriptionPersonalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[8]
+	mi := &file_protos_subscribtion_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +962,7 @@ func (x *ListSubscriptionPersonalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionPersonalResponse.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionPersonalResponse) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{8}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListSubscriptionPersonalResponse) GetSubscriptionPersonal() []*SubscriptionPersonal {
@@ -694,7 +983,7 @@ type CreateSubscriptionGroupRequest struct {
 func (x *CreateSubscriptionGroupRequest) Reset() {
 	*x = CreateSubscriptionGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[9]
+		mi := &file_protos_subscribtion_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +996,7 @@ func (x *CreateSubscriptionGroupRequest) String() string {
 func (*CreateSubscriptionGroupRequest) ProtoMessage() {}
 
 func (x *CreateSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[9]
+	mi := &file_protos_subscribtion_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +1009,7 @@ func (x *CreateSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSubscriptionGroupRequest.ProtoReflect.Descriptor instead.
 func (*CreateSubscriptionGroupRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{9}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateSubscriptionGroupRequest) GetSubscriptionGroup() *SubscriptionGroup {
@@ -741,7 +1030,7 @@ type GetSubscriptionGroupRequest struct {
 func (x *GetSubscriptionGroupRequest) Reset() {
 	*x = GetSubscriptionGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[10]
+		mi := &file_protos_subscribtion_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -754,7 +1043,7 @@ func (x *GetSubscriptionGroupRequest) String() string {
 func (*GetSubscriptionGroupRequest) ProtoMessage() {}
 
 func (x *GetSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[10]
+	mi := &file_protos_subscribtion_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,7 +1056,7 @@ func (x *GetSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionGroupRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionGroupRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{10}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetSubscriptionGroupRequest) GetId() string {
@@ -788,7 +1077,7 @@ type UpdateSubscriptionGroupRequest struct {
 func (x *UpdateSubscriptionGroupRequest) Reset() {
 	*x = UpdateSubscriptionGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[11]
+		mi := &file_protos_subscribtion_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -801,7 +1090,7 @@ func (x *UpdateSubscriptionGroupRequest) String() string {
 func (*UpdateSubscriptionGroupRequest) ProtoMessage() {}
 
 func (x *UpdateSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[11]
+	mi := &file_protos_subscribtion_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1103,7 @@ func (x *UpdateSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSubscriptionGroupRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSubscriptionGroupRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{11}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateSubscriptionGroupRequest) GetSubscriptionGroup() *SubscriptionGroup {
@@ -835,7 +1124,7 @@ type DeleteSubscriptionGroupRequest struct {
 func (x *DeleteSubscriptionGroupRequest) Reset() {
 	*x = DeleteSubscriptionGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[12]
+		mi := &file_protos_subscribtion_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -848,7 +1137,7 @@ func (x *DeleteSubscriptionGroupRequest) String() string {
 func (*DeleteSubscriptionGroupRequest) ProtoMessage() {}
 
 func (x *DeleteSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[12]
+	mi := &file_protos_subscribtion_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,7 +1150,7 @@ func (x *DeleteSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSubscriptionGroupRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSubscriptionGroupRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{12}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteSubscriptionGroupRequest) GetId() string {
@@ -882,7 +1171,7 @@ type ListSubscriptionGroupRequest struct {
 func (x *ListSubscriptionGroupRequest) Reset() {
 	*x = ListSubscriptionGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[13]
+		mi := &file_protos_subscribtion_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1184,7 @@ func (x *ListSubscriptionGroupRequest) String() string {
 func (*ListSubscriptionGroupRequest) ProtoMessage() {}
 
 func (x *ListSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[13]
+	mi := &file_protos_subscribtion_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1197,7 @@ func (x *ListSubscriptionGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionGroupRequest.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionGroupRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{13}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListSubscriptionGroupRequest) GetGymId() string {
@@ -929,7 +1218,7 @@ type ListSubscriptionGroupResponse struct {
 func (x *ListSubscriptionGroupResponse) Reset() {
 	*x = ListSubscriptionGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[14]
+		mi := &file_protos_subscribtion_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1231,7 @@ func (x *ListSubscriptionGroupResponse) String() string {
 func (*ListSubscriptionGroupResponse) ProtoMessage() {}
 
 func (x *ListSubscriptionGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[14]
+	mi := &file_protos_subscribtion_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1244,7 @@ func (x *ListSubscriptionGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionGroupResponse.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionGroupResponse) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{14}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListSubscriptionGroupResponse) GetSubscriptionGroup() []*SubscriptionGroup {
@@ -976,7 +1265,7 @@ type CreateSubscriptionCoachRequest struct {
 func (x *CreateSubscriptionCoachRequest) Reset() {
 	*x = CreateSubscriptionCoachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[15]
+		mi := &file_protos_subscribtion_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -989,7 +1278,7 @@ func (x *CreateSubscriptionCoachRequest) String() string {
 func (*CreateSubscriptionCoachRequest) ProtoMessage() {}
 
 func (x *CreateSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[15]
+	mi := &file_protos_subscribtion_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1291,7 @@ func (x *CreateSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSubscriptionCoachRequest.ProtoReflect.Descriptor instead.
 func (*CreateSubscriptionCoachRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{15}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateSubscriptionCoachRequest) GetSubscriptionCoach() *SubscriptionCoach {
@@ -1023,7 +1312,7 @@ type GetSubscriptionCoachRequest struct {
 func (x *GetSubscriptionCoachRequest) Reset() {
 	*x = GetSubscriptionCoachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[16]
+		mi := &file_protos_subscribtion_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +1325,7 @@ func (x *GetSubscriptionCoachRequest) String() string {
 func (*GetSubscriptionCoachRequest) ProtoMessage() {}
 
 func (x *GetSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[16]
+	mi := &file_protos_subscribtion_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1338,7 @@ func (x *GetSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionCoachRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionCoachRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{16}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetSubscriptionCoachRequest) GetId() string {
@@ -1070,7 +1359,7 @@ type UpdateSubscriptionCoachRequest struct {
 func (x *UpdateSubscriptionCoachRequest) Reset() {
 	*x = UpdateSubscriptionCoachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[17]
+		mi := &file_protos_subscribtion_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1083,7 +1372,7 @@ func (x *UpdateSubscriptionCoachRequest) String() string {
 func (*UpdateSubscriptionCoachRequest) ProtoMessage() {}
 
 func (x *UpdateSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[17]
+	mi := &file_protos_subscribtion_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1385,7 @@ func (x *UpdateSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSubscriptionCoachRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSubscriptionCoachRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{17}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UpdateSubscriptionCoachRequest) GetSubscriptionCoach() *SubscriptionCoach {
@@ -1117,7 +1406,7 @@ type DeleteSubscriptionCoachRequest struct {
 func (x *DeleteSubscriptionCoachRequest) Reset() {
 	*x = DeleteSubscriptionCoachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[18]
+		mi := &file_protos_subscribtion_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1130,7 +1419,7 @@ func (x *DeleteSubscriptionCoachRequest) String() string {
 func (*DeleteSubscriptionCoachRequest) ProtoMessage() {}
 
 func (x *DeleteSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[18]
+	mi := &file_protos_subscribtion_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1432,7 @@ func (x *DeleteSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSubscriptionCoachRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSubscriptionCoachRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{18}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteSubscriptionCoachRequest) GetId() string {
@@ -1164,7 +1453,7 @@ type ListSubscriptionCoachRequest struct {
 func (x *ListSubscriptionCoachRequest) Reset() {
 	*x = ListSubscriptionCoachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[19]
+		mi := &file_protos_subscribtion_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1177,7 +1466,7 @@ func (x *ListSubscriptionCoachRequest) String() string {
 func (*ListSubscriptionCoachRequest) ProtoMessage() {}
 
 func (x *ListSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[19]
+	mi := &file_protos_subscribtion_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1479,7 @@ func (x *ListSubscriptionCoachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionCoachRequest.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionCoachRequest) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{19}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListSubscriptionCoachRequest) GetGymId() string {
@@ -1211,7 +1500,7 @@ type ListSubscriptionCoachResponse struct {
 func (x *ListSubscriptionCoachResponse) Reset() {
 	*x = ListSubscriptionCoachResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_subscribtion_proto_msgTypes[20]
+		mi := &file_protos_subscribtion_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1224,7 +1513,7 @@ func (x *ListSubscriptionCoachResponse) String() string {
 func (*ListSubscriptionCoachResponse) ProtoMessage() {}
 
 func (x *ListSubscriptionCoachResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_subscribtion_proto_msgTypes[20]
+	mi := &file_protos_subscribtion_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +1526,7 @@ func (x *ListSubscriptionCoachResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionCoachResponse.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionCoachResponse) Descriptor() ([]byte, []int) {
-	return file_protos_subscribtion_proto_rawDescGZIP(), []int{20}
+	return file_protos_subscribtion_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListSubscriptionCoachResponse) GetSubscriptionCoach() []*SubscriptionCoach {
@@ -1252,246 +1541,367 @@ var File_protos_subscribtion_proto protoreflect.FileDescriptor
 var file_protos_subscribtion_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
 	0x62, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x79, 0x6d,
-	0x1a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x02, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0xe0, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x73, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x52, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a,
-	0x15, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x33, 0x0a,
-	0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x38, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x20,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a,
+	0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x79, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x60, 0x0a,
+	0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xfa, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x02, 0x0a,
+	0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x58, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x21, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x4e, 0x0a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x14, 0x73, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x22, 0x67, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x15, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x52, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x22, 0x33, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49,
+	0x64, 0x22, 0x72, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52,
+	0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x67, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2d,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x67, 0x0a,
+	0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79,
+	0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
 	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x11,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1d, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x22, 0x67, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x22, 0x2d, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x67, 0x0a, 0x1e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x12,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x61,
-	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x22,
+	0x66, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x67, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x11, 0x73,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68,
-	0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x61, 0x63, 0x68, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1d,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
-	0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x61, 0x63, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63,
-	0x68, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x61, 0x63, 0x68, 0x32, 0xf5, 0x03, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x67, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x79,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x79, 0x6d, 0x49,
+	0x64, 0x22, 0x66, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x32, 0xb1, 0x07, 0x0a, 0x1b, 0x53, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x12, 0x26, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x79, 0x6d,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x1a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x39, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x33, 0x3a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x12, 0x5f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x26,
-	0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x1a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x67, 0x79, 0x6d,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x54, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x3a, 0x15, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x1a, 0x35, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2e,
+	0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
-	0x6c, 0x12, 0x50, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
-	0x26, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x6c, 0x12, 0x26, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8b,
+	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x67, 0x79,
+	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x67, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
-	0x24, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc8, 0x03, 0x0a,
-	0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0xae, 0x01, 0x0a,
+	0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x24, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xe4, 0x06,
+	0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47,
 	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79,
 	0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x56, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23,
-	0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4a, 0x0a, 0x17, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x67, 0x79,
-	0x6d, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x6f, 0x75, 0x70, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x12, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x76, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x21, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
+	0x12, 0x20, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0xa3, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x67,
+	0x79, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x45, 0x3a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x70, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc8, 0x03, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12,
-	0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x50, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x61, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x56,
-	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63,
-	0x68, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x67, 0x79,
+	0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x21, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xa8, 0x01, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x79,
 	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x12, 0x5a, 0x10, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34,
+	0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x32, 0xe4, 0x06, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x8b, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x23, 0x2e,
+	0x67, 0x79, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2d, 0x3a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x12,
+	0x76, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x3a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x1a, 0x2f, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x70, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x23, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
+	0x67, 0x79, 0x6d, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x2a, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x7f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x79,
+	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x12, 0xa8, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x79, 0x6d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x61, 0x63, 0x68,
+	0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x12, 0x5a, 0x10, 0x67,
+	0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1506,76 +1916,88 @@ func file_protos_subscribtion_proto_rawDescGZIP() []byte {
 	return file_protos_subscribtion_proto_rawDescData
 }
 
-var file_protos_subscribtion_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_protos_subscribtion_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_protos_subscribtion_proto_goTypes = []any{
 	(*SubscriptionPersonal)(nil),              // 0: gym.SubscriptionPersonal
-	(*SubscriptionGroup)(nil),                 // 1: gym.SubscriptionGroup
-	(*SubscriptionCoach)(nil),                 // 2: gym.SubscriptionCoach
-	(*CreateSubscriptionPersonalRequest)(nil), // 3: gym.CreateSubscriptionPersonalRequest
-	(*GetSubscriptionPersonalRequest)(nil),    // 4: gym.GetSubscriptionPersonalRequest
-	(*UpdateSubscriptionPersonalRequest)(nil), // 5: gym.UpdateSubscriptionPersonalRequest
-	(*DeleteSubscriptionPersonalRequest)(nil), // 6: gym.DeleteSubscriptionPersonalRequest
-	(*ListSubscriptionPersonalRequest)(nil),   // 7: gym.ListSubscriptionPersonalRequest
-	(*ListSubscriptionPersonalResponse)(nil),  // 8: gym.ListSubscriptionPersonalResponse
-	(*CreateSubscriptionGroupRequest)(nil),    // 9: gym.CreateSubscriptionGroupRequest
-	(*GetSubscriptionGroupRequest)(nil),       // 10: gym.GetSubscriptionGroupRequest
-	(*UpdateSubscriptionGroupRequest)(nil),    // 11: gym.UpdateSubscriptionGroupRequest
-	(*DeleteSubscriptionGroupRequest)(nil),    // 12: gym.DeleteSubscriptionGroupRequest
-	(*ListSubscriptionGroupRequest)(nil),      // 13: gym.ListSubscriptionGroupRequest
-	(*ListSubscriptionGroupResponse)(nil),     // 14: gym.ListSubscriptionGroupResponse
-	(*CreateSubscriptionCoachRequest)(nil),    // 15: gym.CreateSubscriptionCoachRequest
-	(*GetSubscriptionCoachRequest)(nil),       // 16: gym.GetSubscriptionCoachRequest
-	(*UpdateSubscriptionCoachRequest)(nil),    // 17: gym.UpdateSubscriptionCoachRequest
-	(*DeleteSubscriptionCoachRequest)(nil),    // 18: gym.DeleteSubscriptionCoachRequest
-	(*ListSubscriptionCoachRequest)(nil),      // 19: gym.ListSubscriptionCoachRequest
-	(*ListSubscriptionCoachResponse)(nil),     // 20: gym.ListSubscriptionCoachResponse
-	(*Empty)(nil),                             // 21: gym.Empty
+	(*TimeWindow)(nil),                        // 1: gym.TimeWindow
+	(*SubscriptionGroup)(nil),                 // 2: gym.SubscriptionGroup
+	(*SubscriptionCoach)(nil),                 // 3: gym.SubscriptionCoach
+	(*SubscriptionVersion)(nil),               // 4: gym.SubscriptionVersion
+	(*ListSubscriptionVersionsRequest)(nil),   // 5: gym.ListSubscriptionVersionsRequest
+	(*ListSubscriptionVersionsResponse)(nil),  // 6: gym.ListSubscriptionVersionsResponse
+	(*CreateSubscriptionPersonalRequest)(nil), // 7: gym.CreateSubscriptionPersonalRequest
+	(*GetSubscriptionPersonalRequest)(nil),    // 8: gym.GetSubscriptionPersonalRequest
+	(*UpdateSubscriptionPersonalRequest)(nil), // 9: gym.UpdateSubscriptionPersonalRequest
+	(*DeleteSubscriptionPersonalRequest)(nil), // 10: gym.DeleteSubscriptionPersonalRequest
+	(*ListSubscriptionPersonalRequest)(nil),   // 11: gym.ListSubscriptionPersonalRequest
+	(*ListSubscriptionPersonalResponse)(nil),  // 12: gym.ListSubscriptionPersonalResponse
+	(*CreateSubscriptionGroupRequest)(nil),    // 13: gym.CreateSubscriptionGroupRequest
+	(*GetSubscriptionGroupRequest)(nil),       // 14: gym.GetSubscriptionGroupRequest
+	(*UpdateSubscriptionGroupRequest)(nil),    // 15: gym.UpdateSubscriptionGroupRequest
+	(*DeleteSubscriptionGroupRequest)(nil),    // 16: gym.DeleteSubscriptionGroupRequest
+	(*ListSubscriptionGroupRequest)(nil),      // 17: gym.ListSubscriptionGroupRequest
+	(*ListSubscriptionGroupResponse)(nil),     // 18: gym.ListSubscriptionGroupResponse
+	(*CreateSubscriptionCoachRequest)(nil),    // 19: gym.CreateSubscriptionCoachRequest
+	(*GetSubscriptionCoachRequest)(nil),       // 20: gym.GetSubscriptionCoachRequest
+	(*UpdateSubscriptionCoachRequest)(nil),    // 21: gym.UpdateSubscriptionCoachRequest
+	(*DeleteSubscriptionCoachRequest)(nil),    // 22: gym.DeleteSubscriptionCoachRequest
+	(*ListSubscriptionCoachRequest)(nil),      // 23: gym.ListSubscriptionCoachRequest
+	(*ListSubscriptionCoachResponse)(nil),     // 24: gym.ListSubscriptionCoachResponse
+	(*Empty)(nil),                             // 25: gym.Empty
 }
 var file_protos_subscribtion_proto_depIdxs = []int32{
-	0,  // 0: gym.CreateSubscriptionPersonalRequest.subscription_personal:type_name -> gym.SubscriptionPersonal
-	0,  // 1: gym.UpdateSubscriptionPersonalRequest.subscription_personal:type_name -> gym.SubscriptionPersonal
-	0,  // 2: gym.ListSubscriptionPersonalResponse.subscription_personal:type_name -> gym.SubscriptionPersonal
-	1,  // 3: gym.CreateSubscriptionGroupRequest.subscription_group:type_name -> gym.SubscriptionGroup
-	1,  // 4: gym.UpdateSubscriptionGroupRequest.subscription_group:type_name -> gym.SubscriptionGroup
-	1,  // 5: gym.ListSubscriptionGroupResponse.subscription_group:type_name -> gym.SubscriptionGroup
-	2,  // 6: gym.CreateSubscriptionCoachRequest.subscription_coach:type_name -> gym.SubscriptionCoach
-	2,  // 7: gym.UpdateSubscriptionCoachRequest.subscription_coach:type_name -> gym.SubscriptionCoach
-	2,  // 8: gym.ListSubscriptionCoachResponse.subscription_coach:type_name -> gym.SubscriptionCoach
-	3,  // 9: gym.SubscriptionPersonalService.CreateSubscriptionPersonal:input_type -> gym.CreateSubscriptionPersonalRequest
-	4,  // 10: gym.SubscriptionPersonalService.GetSubscriptionPersonal:input_type -> gym.GetSubscriptionPersonalRequest
-	5,  // 11: gym.SubscriptionPersonalService.UpdateSubscriptionPersonal:input_type -> gym.UpdateSubscriptionPersonalRequest
-	6,  // 12: gym.SubscriptionPersonalService.DeleteSubscriptionPersonal:input_type -> gym.DeleteSubscriptionPersonalRequest
-	7,  // 13: gym.SubscriptionPersonalService.ListSubscriptionPersonal:input_type -> gym.ListSubscriptionPersonalRequest
-	9,  // 14: gym.SubscriptionGroupService.CreateSubscriptionGroup:input_type -> gym.CreateSubscriptionGroupRequest
-	10, // 15: gym.SubscriptionGroupService.GetSubscriptionGroup:input_type -> gym.GetSubscriptionGroupRequest
-	11, // 16: gym.SubscriptionGroupService.UpdateSubscriptionGroup:input_type -> gym.UpdateSubscriptionGroupRequest
-	12, // 17: gym.SubscriptionGroupService.DeleteSubscriptionGroup:input_type -> gym.DeleteSubscriptionGroupRequest
-	13, // 18: gym.SubscriptionGroupService.ListSubscriptionGroup:input_type -> gym.ListSubscriptionGroupRequest
-	15, // 19: gym.SubscriptionCoachService.CreateSubscriptionCoach:input_type -> gym.CreateSubscriptionCoachRequest
-	16, // 20: gym.SubscriptionCoachService.GetSubscriptionCoach:input_type -> gym.GetSubscriptionCoachRequest
-	17, // 21: gym.SubscriptionCoachService.UpdateSubscriptionCoach:input_type -> gym.UpdateSubscriptionCoachRequest
-	18, // 22: gym.SubscriptionCoachService.DeleteSubscriptionCoach:input_type -> gym.DeleteSubscriptionCoachRequest
-	19, // 23: gym.SubscriptionCoachService.ListSubscriptionCoach:input_type -> gym.ListSubscriptionCoachRequest
-	0,  // 24: gym.SubscriptionPersonalService.CreateSubscriptionPersonal:output_type -> gym.SubscriptionPersonal
-	0,  // 25: gym.SubscriptionPersonalService.GetSubscriptionPersonal:output_type -> gym.SubscriptionPersonal
-	0,  // 26: gym.SubscriptionPersonalService.UpdateSubscriptionPersonal:output_type -> gym.SubscriptionPersonal
-	21, // 27: gym.SubscriptionPersonalService.DeleteSubscriptionPersonal:output_type -> gym.Empty
-	8,  // 28: gym.SubscriptionPersonalService.ListSubscriptionPersonal:output_type -> gym.ListSubscriptionPersonalResponse
-	1,  // 29: gym.SubscriptionGroupService.CreateSubscriptionGroup:output_type -> gym.SubscriptionGroup
-	1,  // 30: gym.SubscriptionGroupService.GetSubscriptionGroup:output_type -> gym.SubscriptionGroup
-	1,  // 31: gym.SubscriptionGroupService.UpdateSubscriptionGroup:output_type -> gym.SubscriptionGroup
-	21, // 32: gym.SubscriptionGroupService.DeleteSubscriptionGroup:output_type -> gym.Empty
-	14, // 33: gym.SubscriptionGroupService.ListSubscriptionGroup:output_type -> gym.ListSubscriptionGroupResponse
-	2,  // 34: gym.SubscriptionCoachService.CreateSubscriptionCoach:output_type -> gym.SubscriptionCoach
-	2,  // 35: gym.SubscriptionCoachService.GetSubscriptionCoach:output_type -> gym.SubscriptionCoach
-	2,  // 36: gym.SubscriptionCoachService.UpdateSubscriptionCoach:output_type -> gym.SubscriptionCoach
-	21, // 37: gym.SubscriptionCoachService.DeleteSubscriptionCoach:output_type -> gym.Empty
-	20, // 38: gym.SubscriptionCoachService.ListSubscriptionCoach:output_type -> gym.ListSubscriptionCoachResponse
-	24, // [24:39] is the sub-list for method output_type
-	9,  // [9:24] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	1,  // 0: gym.SubscriptionPersonal.time_windows:type_name -> gym.TimeWindow
+	4,  // 1: gym.ListSubscriptionVersionsResponse.versions:type_name -> gym.SubscriptionVersion
+	0,  // 2: gym.CreateSubscriptionPersonalRequest.subscription_personal:type_name -> gym.SubscriptionPersonal
+	0,  // 3: gym.UpdateSubscriptionPersonalRequest.subscription_personal:type_name -> gym.SubscriptionPersonal
+	0,  // 4: gym.ListSubscriptionPersonalResponse.subscription_personal:type_name -> gym.SubscriptionPersonal
+	2,  // 5: gym.CreateSubscriptionGroupRequest.subscription_group:type_name -> gym.SubscriptionGroup
+	2,  // 6: gym.UpdateSubscriptionGroupRequest.subscription_group:type_name -> gym.SubscriptionGroup
+	2,  // 7: gym.ListSubscriptionGroupResponse.subscription_group:type_name -> gym.SubscriptionGroup
+	3,  // 8: gym.CreateSubscriptionCoachRequest.subscription_coach:type_name -> gym.SubscriptionCoach
+	3,  // 9: gym.UpdateSubscriptionCoachRequest.subscription_coach:type_name -> gym.SubscriptionCoach
+	3,  // 10: gym.ListSubscriptionCoachResponse.subscription_coach:type_name -> gym.SubscriptionCoach
+	7,  // 11: gym.SubscriptionPersonalService.CreateSubscriptionPersonal:input_type -> gym.CreateSubscriptionPersonalRequest
+	8,  // 12: gym.SubscriptionPersonalService.GetSubscriptionPersonal:input_type -> gym.GetSubscriptionPersonalRequest
+	9,  // 13: gym.SubscriptionPersonalService.UpdateSubscriptionPersonal:input_type -> gym.UpdateSubscriptionPersonalRequest
+	10, // 14: gym.SubscriptionPersonalService.DeleteSubscriptionPersonal:input_type -> gym.DeleteSubscriptionPersonalRequest
+	11, // 15: gym.SubscriptionPersonalService.ListSubscriptionPersonal:input_type -> gym.ListSubscriptionPersonalRequest
+	5,  // 16: gym.SubscriptionPersonalService.ListSubscriptionPersonalVersions:input_type -> gym.ListSubscriptionVersionsRequest
+	13, // 17: gym.SubscriptionGroupService.CreateSubscriptionGroup:input_type -> gym.CreateSubscriptionGroupRequest
+	14, // 18: gym.SubscriptionGroupService.GetSubscriptionGroup:input_type -> gym.GetSubscriptionGroupRequest
+	15, // 19: gym.SubscriptionGroupService.UpdateSubscriptionGroup:input_type -> gym.UpdateSubscriptionGroupRequest
+	16, // 20: gym.SubscriptionGroupService.DeleteSubscriptionGroup:input_type -> gym.DeleteSubscriptionGroupRequest
+	17, // 21: gym.SubscriptionGroupService.ListSubscriptionGroup:input_type -> gym.ListSubscriptionGroupRequest
+	5,  // 22: gym.SubscriptionGroupService.ListSubscriptionGroupVersions:input_type -> gym.ListSubscriptionVersionsRequest
+	19, // 23: gym.SubscriptionCoachService.CreateSubscriptionCoach:input_type -> gym.CreateSubscriptionCoachRequest
+	20, // 24: gym.SubscriptionCoachService.GetSubscriptionCoach:input_type -> gym.GetSubscriptionCoachRequest
+	21, // 25: gym.SubscriptionCoachService.UpdateSubscriptionCoach:input_type -> gym.UpdateSubscriptionCoachRequest
+	22, // 26: gym.SubscriptionCoachService.DeleteSubscriptionCoach:input_type -> gym.DeleteSubscriptionCoachRequest
+	23, // 27: gym.SubscriptionCoachService.ListSubscriptionCoach:input_type -> gym.ListSubscriptionCoachRequest
+	5,  // 28: gym.SubscriptionCoachService.ListSubscriptionCoachVersions:input_type -> gym.ListSubscriptionVersionsRequest
+	0,  // 29: gym.SubscriptionPersonalService.CreateSubscriptionPersonal:output_type -> gym.SubscriptionPersonal
+	0,  // 30: gym.SubscriptionPersonalService.GetSubscriptionPersonal:output_type -> gym.SubscriptionPersonal
+	0,  // 31: gym.SubscriptionPersonalService.UpdateSubscriptionPersonal:output_type -> gym.SubscriptionPersonal
+	25, // 32: gym.SubscriptionPersonalService.DeleteSubscriptionPersonal:output_type -> gym.Empty
+	12, // 33: gym.SubscriptionPersonalService.ListSubscriptionPersonal:output_type -> gym.ListSubscriptionPersonalResponse
+	6,  // 34: gym.SubscriptionPersonalService.ListSubscriptionPersonalVersions:output_type -> gym.ListSubscriptionVersionsResponse
+	2,  // 35: gym.SubscriptionGroupService.CreateSubscriptionGroup:output_type -> gym.SubscriptionGroup
+	2,  // 36: gym.SubscriptionGroupService.GetSubscriptionGroup:output_type -> gym.SubscriptionGroup
+	2,  // 37: gym.SubscriptionGroupService.UpdateSubscriptionGroup:output_type -> gym.SubscriptionGroup
+	25, // 38: gym.SubscriptionGroupService.DeleteSubscriptionGroup:output_type -> gym.Empty
+	18, // 39: gym.SubscriptionGroupService.ListSubscriptionGroup:output_type -> gym.ListSubscriptionGroupResponse
+	6,  // 40: gym.SubscriptionGroupService.ListSubscriptionGroupVersions:output_type -> gym.ListSubscriptionVersionsResponse
+	3,  // 41: gym.SubscriptionCoachService.CreateSubscriptionCoach:output_type -> gym.SubscriptionCoach
+	3,  // 42: gym.SubscriptionCoachService.GetSubscriptionCoach:output_type -> gym.SubscriptionCoach
+	3,  // 43: gym.SubscriptionCoachService.UpdateSubscriptionCoach:output_type -> gym.SubscriptionCoach
+	25, // 44: gym.SubscriptionCoachService.DeleteSubscriptionCoach:output_type -> gym.Empty
+	24, // 45: gym.SubscriptionCoachService.ListSubscriptionCoach:output_type -> gym.ListSubscriptionCoachResponse
+	6,  // 46: gym.SubscriptionCoachService.ListSubscriptionCoachVersions:output_type -> gym.ListSubscriptionVersionsResponse
+	29, // [29:47] is the sub-list for method output_type
+	11, // [11:29] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_protos_subscribtion_proto_init() }
@@ -1598,7 +2020,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*SubscriptionGroup); i {
+			switch v := v.(*TimeWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1610,7 +2032,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SubscriptionCoach); i {
+			switch v := v.(*SubscriptionGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,7 +2044,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateSubscriptionPersonalRequest); i {
+			switch v := v.(*SubscriptionCoach); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,7 +2056,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSubscriptionPersonalRequest); i {
+			switch v := v.(*SubscriptionVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,7 +2068,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateSubscriptionPersonalRequest); i {
+			switch v := v.(*ListSubscriptionVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1658,7 +2080,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteSubscriptionPersonalRequest); i {
+			switch v := v.(*ListSubscriptionVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1670,7 +2092,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSubscriptionPersonalRequest); i {
+			switch v := v.(*CreateSubscriptionPersonalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1682,7 +2104,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSubscriptionPersonalResponse); i {
+			switch v := v.(*GetSubscriptionPersonalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1694,7 +2116,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateSubscriptionGroupRequest); i {
+			switch v := v.(*UpdateSubscriptionPersonalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1706,7 +2128,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSubscriptionGroupRequest); i {
+			switch v := v.(*DeleteSubscriptionPersonalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1718,7 +2140,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateSubscriptionGroupRequest); i {
+			switch v := v.(*ListSubscriptionPersonalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1730,7 +2152,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteSubscriptionGroupRequest); i {
+			switch v := v.(*ListSubscriptionPersonalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1742,7 +2164,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSubscriptionGroupRequest); i {
+			switch v := v.(*CreateSubscriptionGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1754,7 +2176,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSubscriptionGroupResponse); i {
+			switch v := v.(*GetSubscriptionGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1766,7 +2188,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateSubscriptionCoachRequest); i {
+			switch v := v.(*UpdateSubscriptionGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1778,7 +2200,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSubscriptionCoachRequest); i {
+			switch v := v.(*DeleteSubscriptionGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1790,7 +2212,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateSubscriptionCoachRequest); i {
+			switch v := v.(*ListSubscriptionGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1802,7 +2224,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteSubscriptionCoachRequest); i {
+			switch v := v.(*ListSubscriptionGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1814,7 +2236,7 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSubscriptionCoachRequest); i {
+			switch v := v.(*CreateSubscriptionCoachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1826,6 +2248,54 @@ func file_protos_subscribtion_proto_init() {
 			}
 		}
 		file_protos_subscribtion_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSubscriptionCoachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_subscribtion_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateSubscriptionCoachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_subscribtion_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteSubscriptionCoachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_subscribtion_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSubscriptionCoachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_subscribtion_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSubscriptionCoachResponse); i {
 			case 0:
 				return &v.state
@@ -1844,7 +2314,7 @@ func file_protos_subscribtion_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_subscribtion_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SubscriptionPersonalService_CreateSubscriptionPersonal_FullMethodName = "/gym.SubscriptionPersonalService/CreateSubscriptionPersonal"
-	SubscriptionPersonalService_GetSubscriptionPersonal_FullMethodName    = "/gym.SubscriptionPersonalService/GetSubscriptionPersonal"
-	SubscriptionPersonalService_UpdateSubscriptionPersonal_FullMethodName = "/gym.SubscriptionPersonalService/UpdateSubscriptionPersonal"
-	SubscriptionPersonalService_DeleteSubscriptionPersonal_FullMethodName = "/gym.SubscriptionPersonalService/DeleteSubscriptionPersonal"
-	SubscriptionPersonalService_ListSubscriptionPersonal_FullMethodName   = "/gym.SubscriptionPersonalService/ListSubscriptionPersonal"
+	SubscriptionPersonalService_CreateSubscriptionPersonal_FullMethodName       = "/gym.SubscriptionPersonalService/CreateSubscriptionPersonal"
+	SubscriptionPersonalService_GetSubscriptionPersonal_FullMethodName          = "/gym.SubscriptionPersonalService/GetSubscriptionPersonal"
+	SubscriptionPersonalService_UpdateSubscriptionPersonal_FullMethodName       = "/gym.SubscriptionPersonalService/UpdateSubscriptionPersonal"
+	SubscriptionPersonalService_DeleteSubscriptionPersonal_FullMethodName       = "/gym.SubscriptionPersonalService/DeleteSubscriptionPersonal"
+	SubscriptionPersonalService_ListSubscriptionPersonal_FullMethodName         = "/gym.SubscriptionPersonalService/ListSubscriptionPersonal"
+	SubscriptionPersonalService_ListSubscriptionPersonalVersions_FullMethodName = "/gym.SubscriptionPersonalService/ListSubscriptionPersonalVersions"
 )
 
 // SubscriptionPersonalServiceClient is the client API for SubscriptionPersonalService service.
@@ -35,6 +36,7 @@ type SubscriptionPersonalServiceClient interface {
 	UpdateSubscriptionPersonal(ctx context.Context, in *UpdateSubscriptionPersonalRequest, opts ...grpc.CallOption) (*SubscriptionPersonal, error)
 	DeleteSubscriptionPersonal(ctx context.Context, in *DeleteSubscriptionPersonalRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListSubscriptionPersonal(ctx context.Context, in *ListSubscriptionPersonalRequest, opts ...grpc.CallOption) (*ListSubscriptionPersonalResponse, error)
+	ListSubscriptionPersonalVersions(ctx context.Context, in *ListSubscriptionVersionsRequest, opts ...grpc.CallOption) (*ListSubscriptionVersionsResponse, error)
 }
 
 type subscriptionPersonalServiceClient struct {
@@ -95,6 +97,16 @@ func (c *subscriptionPersonalServiceClient) ListSubscriptionPersonal(ctx context
 	return out, nil
 }
 
+func (c *subscriptionPersonalServiceClient) ListSubscriptionPersonalVersions(ctx context.Context, in *ListSubscriptionVersionsRequest, opts ...grpc.CallOption) (*ListSubscriptionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionVersionsResponse)
+	err := c.cc.Invoke(ctx, SubscriptionPersonalService_ListSubscriptionPersonalVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionPersonalServiceServer is the server API for SubscriptionPersonalService service.
 // All implementations must embed UnimplementedSubscriptionPersonalServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type SubscriptionPersonalServiceServer interface {
 	UpdateSubscriptionPersonal(context.Context, *UpdateSubscriptionPersonalRequest) (*SubscriptionPersonal, error)
 	DeleteSubscriptionPersonal(context.Context, *DeleteSubscriptionPersonalRequest) (*Empty, error)
 	ListSubscriptionPersonal(context.Context, *ListSubscriptionPersonalRequest) (*ListSubscriptionPersonalResponse, error)
+	ListSubscriptionPersonalVersions(context.Context, *ListSubscriptionVersionsRequest) (*ListSubscriptionVersionsResponse, error)
 	mustEmbedUnimplementedSubscriptionPersonalServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedSubscriptionPersonalServiceServer) DeleteSubscriptionPersonal
 func (UnimplementedSubscriptionPersonalServiceServer) ListSubscriptionPersonal(context.Context, *ListSubscriptionPersonalRequest) (*ListSubscriptionPersonalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionPersonal not implemented")
 }
+func (UnimplementedSubscriptionPersonalServiceServer) ListSubscriptionPersonalVersions(context.Context, *ListSubscriptionVersionsRequest) (*ListSubscriptionVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionPersonalVersions not implemented")
+}
 func (UnimplementedSubscriptionPersonalServiceServer) mustEmbedUnimplementedSubscriptionPersonalServiceServer() {
 }
 func (UnimplementedSubscriptionPersonalServiceServer) testEmbeddedByValue() {}
@@ -241,6 +257,24 @@ func _SubscriptionPersonalService_ListSubscriptionPersonal_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionPersonalService_ListSubscriptionPersonalVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionPersonalServiceServer).ListSubscriptionPersonalVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionPersonalService_ListSubscriptionPersonalVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionPersonalServiceServer).ListSubscriptionPersonalVersions(ctx, req.(*ListSubscriptionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubscriptionPersonalService_ServiceDesc is the grpc.ServiceDesc for SubscriptionPersonalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,17 +302,22 @@ var SubscriptionPersonalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscriptionPersonal",
 			Handler:    _SubscriptionPersonalService_ListSubscriptionPersonal_Handler,
 		},
+		{
+			MethodName: "ListSubscriptionPersonalVersions",
+			Handler:    _SubscriptionPersonalService_ListSubscriptionPersonalVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/subscribtion.proto",
 }
 
 const (
-	SubscriptionGroupService_CreateSubscriptionGroup_FullMethodName = "/gym.SubscriptionGroupService/CreateSubscriptionGroup"
-	SubscriptionGroupService_GetSubscriptionGroup_FullMethodName    = "/gym.SubscriptionGroupService/GetSubscriptionGroup"
-	SubscriptionGroupService_UpdateSubscriptionGroup_FullMethodName = "/gym.SubscriptionGroupService/UpdateSubscriptionGroup"
-	SubscriptionGroupService_DeleteSubscriptionGroup_FullMethodName = "/gym.SubscriptionGroupService/DeleteSubscriptionGroup"
-	SubscriptionGroupService_ListSubscriptionGroup_FullMethodName   = "/gym.SubscriptionGroupService/ListSubscriptionGroup"
+	SubscriptionGroupService_CreateSubscriptionGroup_FullMethodName       = "/gym.SubscriptionGroupService/CreateSubscriptionGroup"
+	SubscriptionGroupService_GetSubscriptionGroup_FullMethodName          = "/gym.SubscriptionGroupService/GetSubscriptionGroup"
+	SubscriptionGroupService_UpdateSubscriptionGroup_FullMethodName       = "/gym.SubscriptionGroupService/UpdateSubscriptionGroup"
+	SubscriptionGroupService_DeleteSubscriptionGroup_FullMethodName       = "/gym.SubscriptionGroupService/DeleteSubscriptionGroup"
+	SubscriptionGroupService_ListSubscriptionGroup_FullMethodName         = "/gym.SubscriptionGroupService/ListSubscriptionGroup"
+	SubscriptionGroupService_ListSubscriptionGroupVersions_FullMethodName = "/gym.SubscriptionGroupService/ListSubscriptionGroupVersions"
 )
 
 // SubscriptionGroupServiceClient is the client API for SubscriptionGroupService service.
@@ -290,6 +329,7 @@ type SubscriptionGroupServiceClient interface {
 	UpdateSubscriptionGroup(ctx context.Context, in *UpdateSubscriptionGroupRequest, opts ...grpc.CallOption) (*SubscriptionGroup, error)
 	DeleteSubscriptionGroup(ctx context.Context, in *DeleteSubscriptionGroupRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListSubscriptionGroup(ctx context.Context, in *ListSubscriptionGroupRequest, opts ...grpc.CallOption) (*ListSubscriptionGroupResponse, error)
+	ListSubscriptionGroupVersions(ctx context.Context, in *ListSubscriptionVersionsRequest, opts ...grpc.CallOption) (*ListSubscriptionVersionsResponse, error)
 }
 
 type subscriptionGroupServiceClient struct {
@@ -350,6 +390,16 @@ func (c *subscriptionGroupServiceClient) ListSubscriptionGroup(ctx context.Conte
 	return out, nil
 }
 
+func (c *subscriptionGroupServiceClient) ListSubscriptionGroupVersions(ctx context.Context, in *ListSubscriptionVersionsRequest, opts ...grpc.CallOption) (*ListSubscriptionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionVersionsResponse)
+	err := c.cc.Invoke(ctx, SubscriptionGroupService_ListSubscriptionGroupVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionGroupServiceServer is the server API for SubscriptionGroupService service.
 // All implementations must embed UnimplementedSubscriptionGroupServiceServer
 // for forward compatibility.
@@ -359,6 +409,7 @@ type SubscriptionGroupServiceServer interface {
 	UpdateSubscriptionGroup(context.Context, *UpdateSubscriptionGroupRequest) (*SubscriptionGroup, error)
 	DeleteSubscriptionGroup(context.Context, *DeleteSubscriptionGroupRequest) (*Empty, error)
 	ListSubscriptionGroup(context.Context, *ListSubscriptionGroupRequest) (*ListSubscriptionGroupResponse, error)
+	ListSubscriptionGroupVersions(context.Context, *ListSubscriptionVersionsRequest) (*ListSubscriptionVersionsResponse, error)
 	mustEmbedUnimplementedSubscriptionGroupServiceServer()
 }
 
@@ -384,6 +435,9 @@ func (UnimplementedSubscriptionGroupServiceServer) DeleteSubscriptionGroup(conte
 func (UnimplementedSubscriptionGroupServiceServer) ListSubscriptionGroup(context.Context, *ListSubscriptionGroupRequest) (*ListSubscriptionGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionGroup not implemented")
 }
+func (UnimplementedSubscriptionGroupServiceServer) ListSubscriptionGroupVersions(context.Context, *ListSubscriptionVersionsRequest) (*ListSubscriptionVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionGroupVersions not implemented")
+}
 func (UnimplementedSubscriptionGroupServiceServer) mustEmbedUnimplementedSubscriptionGroupServiceServer() {
 }
 func (UnimplementedSubscriptionGroupServiceServer) testEmbeddedByValue() {}
@@ -496,6 +550,24 @@ func _SubscriptionGroupService_ListSubscriptionGroup_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionGroupService_ListSubscriptionGroupVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionGroupServiceServer).ListSubscriptionGroupVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionGroupService_ListSubscriptionGroupVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionGroupServiceServer).ListSubscriptionGroupVersions(ctx, req.(*ListSubscriptionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubscriptionGroupService_ServiceDesc is the grpc.ServiceDesc for SubscriptionGroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -523,17 +595,22 @@ var SubscriptionGroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscriptionGroup",
 			Handler:    _SubscriptionGroupService_ListSubscriptionGroup_Handler,
 		},
+		{
+			MethodName: "ListSubscriptionGroupVersions",
+			Handler:    _SubscriptionGroupService_ListSubscriptionGroupVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/subscribtion.proto",
 }
 
 const (
-	SubscriptionCoachService_CreateSubscriptionCoach_FullMethodName = "/gym.SubscriptionCoachService/CreateSubscriptionCoach"
-	SubscriptionCoachService_GetSubscriptionCoach_FullMethodName    = "/gym.SubscriptionCoachService/GetSubscriptionCoach"
-	SubscriptionCoachService_UpdateSubscriptionCoach_FullMethodName = "/gym.SubscriptionCoachService/UpdateSubscriptionCoach"
-	SubscriptionCoachService_DeleteSubscriptionCoach_FullMethodName = "/gym.SubscriptionCoachService/DeleteSubscriptionCoach"
-	SubscriptionCoachService_ListSubscriptionCoach_FullMethodName   = "/gym.SubscriptionCoachService/ListSubscriptionCoach"
+	SubscriptionCoachService_CreateSubscriptionCoach_FullMethodName       = "/gym.SubscriptionCoachService/CreateSubscriptionCoach"
+	SubscriptionCoachService_GetSubscriptionCoach_FullMethodName          = "/gym.SubscriptionCoachService/GetSubscriptionCoach"
+	SubscriptionCoachService_UpdateSubscriptionCoach_FullMethodName       = "/gym.SubscriptionCoachService/UpdateSubscriptionCoach"
+	SubscriptionCoachService_DeleteSubscriptionCoach_FullMethodName       = "/gym.SubscriptionCoachService/DeleteSubscriptionCoach"
+	SubscriptionCoachService_ListSubscriptionCoach_FullMethodName         = "/gym.SubscriptionCoachService/ListSubscriptionCoach"
+	SubscriptionCoachService_ListSubscriptionCoachVersions_FullMethodName = "/gym.SubscriptionCoachService/ListSubscriptionCoachVersions"
 )
 
 // SubscriptionCoachServiceClient is the client API for SubscriptionCoachService service.
@@ -545,6 +622,7 @@ type SubscriptionCoachServiceClient interface {
 	UpdateSubscriptionCoach(ctx context.Context, in *UpdateSubscriptionCoachRequest, opts ...grpc.CallOption) (*SubscriptionCoach, error)
 	DeleteSubscriptionCoach(ctx context.Context, in *DeleteSubscriptionCoachRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListSubscriptionCoach(ctx context.Context, in *ListSubscriptionCoachRequest, opts ...grpc.CallOption) (*ListSubscriptionCoachResponse, error)
+	ListSubscriptionCoachVersions(ctx context.Context, in *ListSubscriptionVersionsRequest, opts ...grpc.CallOption) (*ListSubscriptionVersionsResponse, error)
 }
 
 type subscriptionCoachServiceClient struct {
@@ -605,6 +683,16 @@ func (c *subscriptionCoachServiceClient) ListSubscriptionCoach(ctx context.Conte
 	return out, nil
 }
 
+func (c *subscriptionCoachServiceClient) ListSubscriptionCoachVersions(ctx context.Context, in *ListSubscriptionVersionsRequest, opts ...grpc.CallOption) (*ListSubscriptionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionVersionsResponse)
+	err := c.cc.Invoke(ctx, SubscriptionCoachService_ListSubscriptionCoachVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionCoachServiceServer is the server API for SubscriptionCoachService service.
 // All implementations must embed UnimplementedSubscriptionCoachServiceServer
 // for forward compatibility.
@@ -614,6 +702,7 @@ type SubscriptionCoachServiceServer interface {
 	UpdateSubscriptionCoach(context.Context, *UpdateSubscriptionCoachRequest) (*SubscriptionCoach, error)
 	DeleteSubscriptionCoach(context.Context, *DeleteSubscriptionCoachRequest) (*Empty, error)
 	ListSubscriptionCoach(context.Context, *ListSubscriptionCoachRequest) (*ListSubscriptionCoachResponse, error)
+	ListSubscriptionCoachVersions(context.Context, *ListSubscriptionVersionsRequest) (*ListSubscriptionVersionsResponse, error)
 	mustEmbedUnimplementedSubscriptionCoachServiceServer()
 }
 
@@ -639,6 +728,9 @@ func (UnimplementedSubscriptionCoachServiceServer) DeleteSubscriptionCoach(conte
 func (UnimplementedSubscriptionCoachServiceServer) ListSubscriptionCoach(context.Context, *ListSubscriptionCoachRequest) (*ListSubscriptionCoachResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionCoach not implemented")
 }
+func (UnimplementedSubscriptionCoachServiceServer) ListSubscriptionCoachVersions(context.Context, *ListSubscriptionVersionsRequest) (*ListSubscriptionVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionCoachVersions not implemented")
+}
 func (UnimplementedSubscriptionCoachServiceServer) mustEmbedUnimplementedSubscriptionCoachServiceServer() {
 }
 func (UnimplementedSubscriptionCoachServiceServer) testEmbeddedByValue() {}
@@ -751,6 +843,24 @@ func _SubscriptionCoachService_ListSubscriptionCoach_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionCoachService_ListSubscriptionCoachVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionCoachServiceServer).ListSubscriptionCoachVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionCoachService_ListSubscriptionCoachVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionCoachServiceServer).ListSubscriptionCoachVersions(ctx, req.(*ListSubscriptionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubscriptionCoachService_ServiceDesc is the grpc.ServiceDesc for SubscriptionCoachService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -778,6 +888,10 @@ var SubscriptionCoachService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscriptionCoach",
 			Handler:    _SubscriptionCoachService_ListSubscriptionCoach_Handler,
 		},
+		{
+			MethodName: "ListSubscriptionCoachVersions",
+			Handler:    _SubscriptionCoachService_ListSubscriptionCoachVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/subscribtion.proto",
@@ -0,0 +1,91 @@
+// Package health reports whether the service is ready to take requests
+// through the standard gRPC health service.
+package health
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Checker runs a readiness check every interval and publishes the result as
+// the status of the server as a whole and of every service in services.
+type Checker struct {
+	server   *health.Server
+	check    func(ctx context.Context) error
+	services []string
+	interval time.Duration
+	logger   *slog.Logger
+
+	status healthpb.HealthCheckResponse_ServingStatus
+}
+
+// NewChecker creates a Checker publishing to server. The services start out
+// not serving until the first check passes.
+func NewChecker(server *health.Server, check func(ctx context.Context) error, services []string, interval time.Duration, logger *slog.Logger) *Checker {
+	c := &Checker{
+		server:   server,
+		check:    check,
+		services: services,
+		interval: interval,
+		logger:   logger,
+		status:   healthpb.HealthCheckResponse_NOT_SERVING,
+	}
+	c.publish(c.status)
+	return c
+}
+
+// Run checks readiness every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs the readiness check once, giving it at most one interval, and
+// publishes the result.
+func (c *Checker) Check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.interval)
+	defer cancel()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	err := c.check(ctx)
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	if status != c.status {
+		if err != nil {
+			c.logger.ErrorContext(ctx, "readiness check failed", "error", err)
+		} else {
+			c.logger.InfoContext(ctx, "readiness check passed")
+		}
+		c.status = status
+	}
+	c.publish(status)
+}
+
+// Shutdown reports every service as not serving from now on, so load
+// balancers stop sending requests while the server drains.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+func (c *Checker) publish(status healthpb.HealthCheckResponse_ServingStatus) {
+	c.server.SetServingStatus("", status)
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
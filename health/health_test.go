@@ -0,0 +1,44 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func status(t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestChecker(t *testing.T) {
+	server := health.NewServer()
+	var dbErr error
+	checker := NewChecker(server, func(ctx context.Context) error { return dbErr }, []string{"gym.BookingPersonalService"}, time.Second, slog.Default())
+
+	// Not serving until the first check passes
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(t, server, ""))
+
+	checker.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(t, server, "gym.BookingPersonalService"))
+
+	// Losing the database flips every service
+	dbErr = errors.New("connection refused")
+	checker.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(t, server, "gym.BookingPersonalService"))
+
+	// Once draining, a passing check no longer reports serving
+	dbErr = nil
+	checker.Shutdown()
+	checker.Check(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(t, server, ""))
+}
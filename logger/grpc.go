@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor logs every unary RPC once it completes. It must run
+// after audit.UnaryServerInterceptor so the line carries the request ID.
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logRPC(ctx, logger, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor logs every streaming RPC once it completes. It
+// must run after audit.StreamServerInterceptor.
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logRPC(ss.Context(), logger, start, err)
+		return err
+	}
+}
+
+// logRPC logs how an RPC ended: at info when it succeeded, warn when the
+// caller was at fault and error otherwise.
+func logRPC(ctx context.Context, logger *slog.Logger, start time.Time, err error) {
+	code := rpcCode(err)
+	attrs := []any{
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	level := slog.LevelError
+	switch code {
+	case codes.OK:
+		level = slog.LevelInfo
+	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.FailedPrecondition, codes.OutOfRange, codes.Unauthenticated:
+		level = slog.LevelWarn
+	}
+	logger.Log(ctx, level, "rpc finished", attrs...)
+}
+
+// rpcCode returns the status code an RPC ended with, treating a handler that
+// gave up because its context ended as cancelled or timed out.
+func rpcCode(err error) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return status.FromContextError(err).Code()
+}
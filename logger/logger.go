@@ -0,0 +1,112 @@
+// Package logger builds the service's structured logger. Every line logged
+// with a request context carries the request ID, RPC method and caller, and
+// personal data is redacted before it is written.
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Formats accepted by New.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Options configures the logger built by New.
+type Options struct {
+	Level      string // debug, info, warn or error
+	Format     string // one of the Formats
+	Path       string // file logs are also written to; empty logs to stdout only
+	MaxSizeMB  int    // size at which the file is rotated
+	MaxBackups int    // rotated files kept
+	MaxAgeDays int    // days rotated files are kept
+}
+
+// New creates a logger writing to stdout and, when opts.Path is set, to a
+// file rotated by size. The returned closer releases the file.
+func New(opts Options) (*slog.Logger, io.Closer, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(opts.Level)); err != nil {
+		return nil, nil, fmt.Errorf("invalid log level %q", opts.Level)
+	}
+
+	var (
+		out    io.Writer = os.Stdout
+		closer io.Closer = io.NopCloser(nil)
+	)
+	if opts.Path != "" {
+		file := &lumberjack.Logger{
+			Filename:   opts.Path,
+			MaxSize:    opts.MaxSizeMB,
+			MaxBackups: opts.MaxBackups,
+			MaxAge:     opts.MaxAgeDays,
+		}
+		out = io.MultiWriter(os.Stdout, file)
+		closer = file
+	}
+
+	handlerOpts := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: Redact,
+	}
+
+	var handler slog.Handler
+	switch opts.Format {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(out, handlerOpts)
+	case FormatText:
+		handler = slog.NewTextHandler(out, handlerOpts)
+	default:
+		return nil, nil, fmt.Errorf("unknown log format %q", opts.Format)
+	}
+
+	return slog.New(NewContextHandler(handler)), closer, nil
+}
+
+// ContextHandler adds the request ID, RPC method, caller ID and trace ID
+// found in the context to every record.
+type ContextHandler struct {
+	slog.Handler
+}
+
+// NewContextHandler wraps next in a ContextHandler.
+func NewContextHandler(next slog.Handler) *ContextHandler {
+	return &ContextHandler{Handler: next}
+}
+
+// Handle implements slog.Handler.
+func (h *ContextHandler) Handle(ctx context.Context, record slog.Record) error {
+	md := audit.FromContext(ctx)
+	if md.RequestID != "" {
+		record.AddAttrs(slog.String("request_id", md.RequestID))
+	}
+	if md.RPC != "" {
+		record.AddAttrs(slog.String("rpc", md.RPC))
+	}
+	if md.Actor != "" {
+		record.AddAttrs(slog.String("caller_id", md.Actor))
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		record.AddAttrs(slog.String("trace_id", span.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewContextHandler(h.Handler.WithAttrs(attrs))
+}
+
+// WithGroup implements slog.Handler.
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return NewContextHandler(h.Handler.WithGroup(name))
+}
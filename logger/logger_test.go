@@ -0,0 +1,101 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(NewContextHandler(slog.NewTextHandler(buf, &slog.HandlerOptions{ReplaceAttr: Redact})))
+}
+
+func TestContextHandler(t *testing.T) {
+	var buf bytes.Buffer
+	log := newTestLogger(&buf)
+
+	ctx := audit.NewContext(context.Background(), audit.Metadata{
+		Actor:     "staff-1",
+		RPC:       "/gym.BookingPersonalService/CreateBookingPersonal",
+		RequestID: "req-1",
+	})
+	log.InfoContext(ctx, "booking created")
+
+	line := buf.String()
+	assert.Contains(t, line, "request_id=req-1")
+	assert.Contains(t, line, "rpc=/gym.BookingPersonalService/CreateBookingPersonal")
+	assert.Contains(t, line, "caller_id=staff-1")
+
+	// Lines logged outside a request carry none of them
+	buf.Reset()
+	log.Info("relay started")
+	assert.NotContains(t, buf.String(), "request_id")
+}
+
+func TestRedact(t *testing.T) {
+	var buf bytes.Buffer
+	log := newTestLogger(&buf)
+
+	log.Info("pass issued",
+		"phone_number", "+998901234567",
+		"email", "member@example.com",
+		"secret", "whsec_abc",
+		"user_id", "550e8400-e29b-41d4-a716-446655440000",
+		"error", errors.New(`duplicate key: Key (phone_number)=(998901234567) already exists`),
+	)
+
+	line := buf.String()
+	assert.Contains(t, line, "phone_number=+**********67")
+	assert.Contains(t, line, "email=m***@example.com")
+	assert.Contains(t, line, "secret=[REDACTED]")
+	assert.Contains(t, line, "user_id=550e8400-e29b-41d4-a716-446655440000")
+	assert.Contains(t, line, "(**********67)")
+	assert.NotContains(t, line, "998901234567")
+}
+
+func TestMaskPhones(t *testing.T) {
+	assert.Equal(t, "call **********67 or **********68", maskPhones("call 998901234567 or 998901234568"))
+	assert.Equal(t, "booking 12345 of 2024", maskPhones("booking 12345 of 2024"))
+	assert.Equal(t, "id 446655440000-x", maskPhones("id 446655440000-x"))
+}
+
+func TestNewRejectsUnknownFormat(t *testing.T) {
+	_, _, err := New(Options{Level: "info", Format: "xml"})
+	assert.Error(t, err)
+
+	_, _, err = New(Options{Level: "loud", Format: FormatJSON})
+	assert.Error(t, err)
+}
+
+func TestUnaryServerInterceptorLevels(t *testing.T) {
+	var buf bytes.Buffer
+	interceptor := UnaryServerInterceptor(newTestLogger(&buf))
+	info := &grpc.UnaryServerInfo{FullMethod: "/gym.BookingPersonalService/GetBookingPersonal"}
+
+	tests := []struct {
+		err   error
+		level string
+	}{
+		{err: nil, level: "level=INFO"},
+		{err: status.Error(codes.NotFound, "failed to get personal booking: no rows in result set"), level: `level=WARN msg="rpc finished" code=NotFound`},
+		{err: fmt.Errorf("error getting booking: %w", context.Canceled), level: `level=WARN msg="rpc finished" code=Canceled`},
+		{err: status.Error(codes.Internal, "failed to get personal booking"), level: `level=ERROR msg="rpc finished" code=Internal`},
+	}
+
+	for _, tt := range tests {
+		buf.Reset()
+		_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, tt.err
+		})
+		assert.Contains(t, buf.String(), tt.level)
+	}
+}
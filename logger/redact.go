@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces secrets outright.
+const redacted = "[REDACTED]"
+
+// digitRun matches candidate phone numbers: runs of digits, optionally with
+// a leading plus.
+var digitRun = regexp.MustCompile(`\+?\d+`)
+
+// Redact is a slog ReplaceAttr function. Secrets are dropped, phone numbers
+// and emails under a matching key are masked, and phone numbers inside any
+// other string, such as an error from the database, are masked too.
+func Redact(groups []string, a slog.Attr) slog.Attr {
+	key := strings.ToLower(a.Key)
+	switch {
+	case strings.Contains(key, "password"), strings.Contains(key, "secret"), strings.Contains(key, "token"):
+		return slog.String(a.Key, redacted)
+	case strings.Contains(key, "phone"):
+		return slog.String(a.Key, maskPhone(a.Value.String()))
+	case strings.Contains(key, "email"):
+		return slog.String(a.Key, maskEmail(a.Value.String()))
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		if s := a.Value.String(); digitRun.MatchString(s) {
+			return slog.String(a.Key, maskPhones(s))
+		}
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, maskPhones(err.Error()))
+		}
+	}
+	return a
+}
+
+// maskPhones masks every phone number in s: runs of 9 to 15 digits that are
+// not joined to letters or dashes, as the digits of a UUID are.
+func maskPhones(s string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range digitRun.FindAllStringIndex(s, -1) {
+		start, end := loc[0], loc[1]
+		digits := end - start
+		if s[start] == '+' {
+			digits--
+		}
+		if digits < 9 || digits > 15 || joined(s, start-1) || joined(s, end) {
+			continue
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(maskPhone(s[start:end]))
+		last = end
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// joined reports whether the byte at i continues a word, so digits next to
+// it are part of an identifier rather than a phone number.
+func joined(s string, i int) bool {
+	if i < 0 || i >= len(s) {
+		return false
+	}
+	c := s[i]
+	return c == '-' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// maskPhone keeps the leading plus and the last two digits of phone.
+func maskPhone(phone string) string {
+	var b strings.Builder
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	seen := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			seen++
+			if seen <= digits-2 {
+				r = '*'
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// maskEmail keeps the first letter of the mailbox and the domain.
+func maskEmail(email string) string {
+	name, domain, ok := strings.Cut(email, "@")
+	if !ok || name == "" {
+		return redacted
+	}
+	return name[:1] + "***@" + domain
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dbConnects = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "athlevo_db_connects_total",
+		Help: "Attempts to open a database connection, by outcome.",
+	}, []string{"outcome"})
+
+	dbQueriesInFlight = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "athlevo_db_queries_in_flight",
+		Help: "Queries currently running across all database connections.",
+	})
+
+	dbQueryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "athlevo_db_query_duration_seconds",
+		Help:    "Time taken by database queries, by outcome.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"outcome"})
+)
+
+// DBTracer records connection and query metrics for every pgx connection it
+// is set as the tracer of. Pool statistics are reported by PoolCollector.
+type DBTracer struct{}
+
+type queryStartKey struct{}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (DBTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	dbQueriesInFlight.Inc()
+	return context.WithValue(ctx, queryStartKey{}, time.Now())
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (DBTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	dbQueriesInFlight.Dec()
+	if start, ok := ctx.Value(queryStartKey{}).(time.Time); ok {
+		dbQueryDuration.WithLabelValues(outcome(data.Err)).Observe(time.Since(start).Seconds())
+	}
+}
+
+// TraceConnectStart implements pgx.ConnectTracer.
+func (DBTracer) TraceConnectStart(ctx context.Context, data pgx.TraceConnectStartData) context.Context {
+	return ctx
+}
+
+// TraceConnectEnd implements pgx.ConnectTracer.
+func (DBTracer) TraceConnectEnd(ctx context.Context, data pgx.TraceConnectEndData) {
+	dbConnects.WithLabelValues(outcome(data.Err)).Inc()
+}
+
+var (
+	poolAcquiredConns = prometheus.NewDesc("athlevo_db_pool_acquired_conns",
+		"Connections currently checked out of the pool.", nil, nil)
+	poolIdleConns = prometheus.NewDesc("athlevo_db_pool_idle_conns",
+		"Connections open and waiting in the pool.", nil, nil)
+	poolTotalConns = prometheus.NewDesc("athlevo_db_pool_total_conns",
+		"Connections open or being opened by the pool.", nil, nil)
+	poolMaxConns = prometheus.NewDesc("athlevo_db_pool_max_conns",
+		"Most connections the pool opens.", nil, nil)
+	poolAcquires = prometheus.NewDesc("athlevo_db_pool_acquires_total",
+		"Connections successfully acquired from the pool.", nil, nil)
+	poolEmptyAcquires = prometheus.NewDesc("athlevo_db_pool_empty_acquires_total",
+		"Acquires that had to wait because the pool had no idle connection.", nil, nil)
+	poolEmptyAcquireWait = prometheus.NewDesc("athlevo_db_pool_empty_acquire_wait_seconds_total",
+		"Time spent waiting by acquires that found the pool empty.", nil, nil)
+)
+
+// PoolCollector reports the statistics of a connection pool each time
+// metrics are gathered.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+}
+
+// NewPoolCollector returns a collector for pool, to be registered with
+// Registry.
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{pool: pool}
+}
+
+// Describe implements prometheus.Collector.
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolAcquiredConns
+	ch <- poolIdleConns
+	ch <- poolTotalConns
+	ch <- poolMaxConns
+	ch <- poolAcquires
+	ch <- poolEmptyAcquires
+	ch <- poolEmptyAcquireWait
+}
+
+// Collect implements prometheus.Collector.
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(poolAcquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(poolIdleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(poolTotalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(poolMaxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquireWait, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds())
+}
+
+// outcome labels an operation by whether it failed. Zero rows is a result,
+// not a failure.
+func outcome(err error) string {
+	if err != nil && err != pgx.ErrNoRows {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcStarted = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_started_total",
+		Help: "RPCs started on the server.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+
+	rpcHandled = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "RPCs completed on the server, by status code.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method", "grpc_code"})
+
+	rpcDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time taken to handle RPCs on the server.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+)
+
+// UnaryServerInterceptor records the count, status code and latency of each
+// unary RPC.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	done := observeRPC("unary", info.FullMethod)
+	resp, err := handler(ctx, req)
+	done(err)
+	return resp, err
+}
+
+// StreamServerInterceptor records the count, status code and duration of
+// each streaming RPC.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	done := observeRPC("stream", info.FullMethod)
+	err := handler(srv, ss)
+	done(err)
+	return err
+}
+
+// observeRPC counts an RPC as started and returns a function that records
+// how it ended.
+func observeRPC(rpcType, fullMethod string) func(error) {
+	service, method := splitMethod(fullMethod)
+	rpcStarted.WithLabelValues(rpcType, service, method).Inc()
+	start := time.Now()
+
+	return func(err error) {
+		rpcHandled.WithLabelValues(rpcType, service, method, code(err).String()).Inc()
+		rpcDuration.WithLabelValues(rpcType, service, method).Observe(time.Since(start).Seconds())
+	}
+}
+
+// code returns the status code an RPC ended with. Errors from the services
+// carry their own code, and a handler that gave up because its context ended
+// is counted as cancelled or timed out rather than Unknown.
+func code(err error) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return status.FromContextError(err).Code()
+}
+
+// splitMethod splits "/gym.BookingPersonalService/CreateBookingPersonal"
+// into its service and method.
+func splitMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", "unknown"
+	}
+	return service, method
+}
@@ -0,0 +1,53 @@
+// Package metrics exposes Prometheus metrics for the gRPC server, the
+// database connections and business volumes.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds every metric of the service, along with Go runtime and
+// process metrics.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Business metrics.
+var (
+	// BookingsCreated counts bookings by type: personal, group or coach.
+	BookingsCreated = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "athlevo_bookings_created_total",
+		Help: "Bookings created, by booking type.",
+	}, []string{"type"})
+
+	// AccessDecisions counts turnstile decisions. reason is empty for
+	// granted entries.
+	AccessDecisions = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "athlevo_access_decisions_total",
+		Help: "Access checks, by result and denial reason.",
+	}, []string{"result", "reason"})
+
+	// GroupCapacityRejections counts group bookings refused because the class
+	// was full.
+	GroupCapacityRejections = factory.NewCounter(prometheus.CounterOpts{
+		Name: "athlevo_group_capacity_rejections_total",
+		Help: "Group bookings rejected because the group was full.",
+	})
+)
+
+// Handler serves the metrics in Registry.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/gym.BookingGroupService/CreateBookingGroup"}
+
+	_, err := UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+
+	_, err = UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.FailedPrecondition, "group is full")
+	})
+	require.Error(t, err)
+
+	_, err = UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("error counting members: %w", context.DeadlineExceeded)
+	})
+	require.Error(t, err)
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(rpcStarted.WithLabelValues("unary", "gym.BookingGroupService", "CreateBookingGroup")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(rpcHandled.WithLabelValues("unary", "gym.BookingGroupService", "CreateBookingGroup", "OK")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(rpcHandled.WithLabelValues("unary", "gym.BookingGroupService", "CreateBookingGroup", "FailedPrecondition")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(rpcHandled.WithLabelValues("unary", "gym.BookingGroupService", "CreateBookingGroup", "DeadlineExceeded")))
+}
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/gym.AccessServiceBeta/CheckUserAccess")
+	assert.Equal(t, "gym.AccessServiceBeta", service)
+	assert.Equal(t, "CheckUserAccess", method)
+
+	service, method = splitMethod("malformed")
+	assert.Equal(t, "unknown", service)
+	assert.Equal(t, "unknown", method)
+}
+
+func TestHandler(t *testing.T) {
+	BookingsCreated.WithLabelValues("personal").Inc()
+	AccessDecisions.WithLabelValues("denied", "outside allowed hours").Inc()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body := rec.Body.String()
+	assert.True(t, strings.Contains(body, `athlevo_bookings_created_total{type="personal"} 1`))
+	assert.True(t, strings.Contains(body, `athlevo_access_decisions_total{reason="outside allowed hours",result="denied"} 1`))
+	assert.True(t, strings.Contains(body, "go_goroutines"))
+}
+
+func TestPoolCollector(t *testing.T) {
+	// The pool connects lazily, so no database is needed to read its stats
+	pool, err := pgxpool.New(context.Background(), "postgres://localhost:1/booking?pool_max_conns=7")
+	require.NoError(t, err)
+	defer pool.Close()
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewPoolCollector(pool))
+
+	expected := `
+# HELP athlevo_db_pool_max_conns Most connections the pool opens.
+# TYPE athlevo_db_pool_max_conns gauge
+athlevo_db_pool_max_conns 7
+# HELP athlevo_db_pool_acquired_conns Connections currently checked out of the pool.
+# TYPE athlevo_db_pool_acquired_conns gauge
+athlevo_db_pool_acquired_conns 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "athlevo_db_pool_max_conns", "athlevo_db_pool_acquired_conns"))
+}
@@ -0,0 +1,115 @@
+DROP TRIGGER IF EXISTS trigger_refresh_booking_personal_access ON access_personal;
+DROP TRIGGER IF EXISTS trigger_refresh_booking_group_access ON access_group;
+DROP TRIGGER IF EXISTS trigger_refresh_booking_coach_access ON access_coach;
+
+DROP FUNCTION IF EXISTS refresh_booking_personal_access();
+DROP FUNCTION IF EXISTS refresh_booking_group_access();
+DROP FUNCTION IF EXISTS refresh_booking_coach_access();
+
+CREATE TRIGGER trigger_update_booking_personal_access_for_access
+BEFORE INSERT OR UPDATE ON access_personal
+FOR EACH ROW EXECUTE PROCEDURE update_booking_personal_access();
+
+CREATE TRIGGER trigger_update_booking_group_access_for_access
+BEFORE INSERT OR UPDATE ON access_group
+FOR EACH ROW EXECUTE PROCEDURE update_booking_group_access();
+
+CREATE TRIGGER trigger_update_booking_coach_access_for_access
+BEFORE INSERT OR UPDATE ON access_coach
+FOR EACH ROW EXECUTE PROCEDURE update_booking_coach_access();
+
+CREATE OR REPLACE FUNCTION update_booking_personal_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  SELECT duration, count INTO STRICT subscription_duration, subscription_count
+  FROM subscription_personal
+  WHERE id = NEW.subscription_id;
+
+  SELECT COUNT(*) INTO access_count
+  FROM access_personal
+  WHERE booking_id = NEW.id;
+
+  booking_start_date := NEW.start_date;
+
+  IF NEW.payment >= (SELECT price FROM subscription_personal WHERE id = NEW.subscription_id) AND
+     booking_start_date >= NOW() AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_group_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  SELECT duration, count INTO STRICT subscription_duration, subscription_count
+  FROM subscription_group
+  WHERE id = NEW.subscription_id;
+
+  SELECT COUNT(*) INTO access_count
+  FROM access_group
+  WHERE booking_id = NEW.id;
+
+  booking_start_date := NEW.start_date;
+
+  IF NEW.payment >= (SELECT price FROM subscription_group WHERE id = NEW.subscription_id) AND
+     booking_start_date >= NOW() AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_coach_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_duration INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  SELECT duration INTO STRICT subscription_duration
+  FROM subscription_coach
+  WHERE id = NEW.subscription_id;
+
+  booking_start_date := NEW.start_date;
+
+  IF NEW.payment >= (SELECT price FROM subscription_coach WHERE id = NEW.subscription_id) AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 hour') > NOW()) AND
+     booking_start_date > NOW() THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE booking_coach DROP COLUMN IF EXISTS subscription_version;
+ALTER TABLE booking_group DROP COLUMN IF EXISTS subscription_version;
+ALTER TABLE booking_personal DROP COLUMN IF EXISTS subscription_version;
+
+ALTER TABLE subscription_coach DROP COLUMN IF EXISTS version;
+ALTER TABLE subscription_group DROP COLUMN IF EXISTS version;
+ALTER TABLE subscription_personal DROP COLUMN IF EXISTS version;
+
+DROP TABLE IF EXISTS subscription_versions;
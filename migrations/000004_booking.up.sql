@@ -0,0 +1,209 @@
+-- Immutable pricing snapshots for every subscription plan. A booking pins the
+-- version it was sold under, so editing a plan never changes the terms of
+-- bookings that were already paid for.
+CREATE TABLE IF NOT EXISTS subscription_versions (
+    subscription_id UUID NOT NULL,
+    subscription_type VARCHAR(20) NOT NULL,
+    version INT NOT NULL,
+    price INT,
+    duration INT,
+    count INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (subscription_type, subscription_id, version)
+);
+
+ALTER TABLE subscription_personal ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE subscription_group ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE subscription_coach ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+
+INSERT INTO subscription_versions (subscription_id, subscription_type, version, price, duration, count, created_at)
+SELECT id, 'personal', 1, price, duration, count, created_at FROM subscription_personal
+ON CONFLICT DO NOTHING;
+
+INSERT INTO subscription_versions (subscription_id, subscription_type, version, price, duration, count, created_at)
+SELECT id, 'group', 1, price, duration, count, created_at FROM subscription_group
+ON CONFLICT DO NOTHING;
+
+INSERT INTO subscription_versions (subscription_id, subscription_type, version, price, duration, count, created_at)
+SELECT id, 'coach', 1, price, duration, 0, created_at FROM subscription_coach
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE booking_personal ADD COLUMN IF NOT EXISTS subscription_version INT;
+ALTER TABLE booking_group ADD COLUMN IF NOT EXISTS subscription_version INT;
+ALTER TABLE booking_coach ADD COLUMN IF NOT EXISTS subscription_version INT;
+
+UPDATE booking_personal SET subscription_version = 1 WHERE subscription_version IS NULL;
+UPDATE booking_group SET subscription_version = 1 WHERE subscription_version IS NULL;
+UPDATE booking_coach SET subscription_version = 1 WHERE subscription_version IS NULL;
+
+-- The access status triggers now read the pinned version instead of the live plan row.
+CREATE OR REPLACE FUNCTION update_booking_personal_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_personal
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'personal'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_personal
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.payment >= subscription_price AND
+     booking_start_date >= NOW() AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_group_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_group
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'group'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_group
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.payment >= subscription_price AND
+     booking_start_date >= NOW() AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_coach_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_coach
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price and duration of the pinned version
+  SELECT price, duration INTO STRICT subscription_price, subscription_duration
+  FROM subscription_versions
+  WHERE subscription_type = 'coach'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check if payment is sufficient, booking is within the valid period, and start_date is in the future
+  IF NEW.payment >= subscription_price AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 hour') > NOW()) AND
+     booking_start_date > NOW() THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- The triggers from 000003 ran the booking functions against access rows,
+-- which have no subscription columns. Recompute the owning booking instead,
+-- after the visit has been logged, so the new visit counts against the
+-- pinned version's limit.
+DROP TRIGGER IF EXISTS trigger_update_booking_personal_access_for_access ON access_personal;
+DROP TRIGGER IF EXISTS trigger_update_booking_group_access_for_access ON access_group;
+DROP TRIGGER IF EXISTS trigger_update_booking_coach_access_for_access ON access_coach;
+
+CREATE OR REPLACE FUNCTION refresh_booking_personal_access()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE booking_personal SET updated_at = NOW() WHERE id = NEW.booking_id;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_booking_group_access()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE booking_group SET updated_at = NOW() WHERE id = NEW.booking_id;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_booking_coach_access()
+RETURNS TRIGGER AS $$
+BEGIN
+  UPDATE booking_coach SET updated_at = NOW() WHERE id = NEW.booking_id;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_refresh_booking_personal_access
+AFTER INSERT ON access_personal
+FOR EACH ROW EXECUTE PROCEDURE refresh_booking_personal_access();
+
+CREATE TRIGGER trigger_refresh_booking_group_access
+AFTER INSERT ON access_group
+FOR EACH ROW EXECUTE PROCEDURE refresh_booking_group_access();
+
+CREATE TRIGGER trigger_refresh_booking_coach_access
+AFTER INSERT ON access_coach
+FOR EACH ROW EXECUTE PROCEDURE refresh_booking_coach_access();
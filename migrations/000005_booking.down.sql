@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS access_pass;
+DROP INDEX IF EXISTS passes_trial_phone_idx;
+DROP INDEX IF EXISTS passes_trial_user_idx;
+DROP TABLE IF EXISTS passes;
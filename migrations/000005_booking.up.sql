@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS passes (
+    id UUID PRIMARY KEY,
+    gym_id UUID NOT NULL REFERENCES sport_halls(id),
+    user_id UUID, -- REFERENCES users(id),
+    phone_number VARCHAR(20),
+    type VARCHAR(20) NOT NULL,
+    visits INT NOT NULL DEFAULT 0,
+    visits_used INT NOT NULL DEFAULT 0,
+    price INT NOT NULL DEFAULT 0,
+    valid_from TIMESTAMP NOT NULL,
+    valid_until TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (user_id IS NOT NULL OR phone_number IS NOT NULL)
+);
+
+-- One trial per person per gym
+CREATE UNIQUE INDEX IF NOT EXISTS passes_trial_user_idx ON passes (gym_id, user_id) WHERE type = 'trial' AND user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS passes_trial_phone_idx ON passes (gym_id, phone_number) WHERE type = 'trial' AND phone_number IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS access_pass (
+    pass_id UUID REFERENCES passes(id),
+    date TIMESTAMP
+);
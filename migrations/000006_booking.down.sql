@@ -0,0 +1,6 @@
+ALTER TABLE access_coach DROP COLUMN IF EXISTS user_id;
+ALTER TABLE access_group DROP COLUMN IF EXISTS user_id;
+ALTER TABLE access_personal DROP COLUMN IF EXISTS user_id;
+
+DROP INDEX IF EXISTS booking_members_user_id_idx;
+DROP TABLE IF EXISTS booking_members;
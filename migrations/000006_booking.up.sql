@@ -0,0 +1,21 @@
+-- Additional people sharing a booking. A visit_limit of 0 means the member
+-- draws from the booking's pooled visits.
+CREATE TABLE IF NOT EXISTS booking_members (
+    booking_id UUID NOT NULL,
+    booking_type VARCHAR(20) NOT NULL,
+    user_id UUID NOT NULL, -- REFERENCES users(id),
+    visit_limit INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (booking_type, booking_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS booking_members_user_id_idx ON booking_members (user_id);
+
+-- Record who used each visit so per-member limits can be enforced
+ALTER TABLE access_personal ADD COLUMN IF NOT EXISTS user_id UUID;
+ALTER TABLE access_group ADD COLUMN IF NOT EXISTS user_id UUID;
+ALTER TABLE access_coach ADD COLUMN IF NOT EXISTS user_id UUID;
+
+UPDATE access_personal a SET user_id = b.user_id FROM booking_personal b WHERE b.id = a.booking_id AND a.user_id IS NULL;
+UPDATE access_group a SET user_id = b.user_id FROM booking_group b WHERE b.id = a.booking_id AND a.user_id IS NULL;
+UPDATE access_coach a SET user_id = b.user_id FROM booking_coach b WHERE b.id = a.booking_id AND a.user_id IS NULL;
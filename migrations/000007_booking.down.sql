@@ -0,0 +1,129 @@
+DROP TABLE IF EXISTS booking_transfers;
+DROP TABLE IF EXISTS subscription_transfer_rules;
+
+CREATE OR REPLACE FUNCTION update_booking_personal_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_personal
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'personal'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_personal
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.payment >= subscription_price AND
+     booking_start_date >= NOW() AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_group_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_group
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'group'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_group
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.payment >= subscription_price AND
+     booking_start_date >= NOW() AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_coach_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_coach
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price and duration of the pinned version
+  SELECT price, duration INTO STRICT subscription_price, subscription_duration
+  FROM subscription_versions
+  WHERE subscription_type = 'coach'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check if payment is sufficient, booking is within the valid period, and start_date is in the future
+  IF NEW.payment >= subscription_price AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 hour') > NOW()) AND
+     booking_start_date > NOW() THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,49 @@
+-- Restore the trigger function from 000007
+CREATE OR REPLACE FUNCTION update_booking_personal_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_personal
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'personal'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_personal
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.payment >= subscription_price AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS booking_plan_changes;
+
+ALTER TABLE booking_personal DROP COLUMN IF EXISTS closed_reason;
+ALTER TABLE booking_personal DROP COLUMN IF EXISTS closed_at;
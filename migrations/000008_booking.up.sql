@@ -0,0 +1,61 @@
+ALTER TABLE booking_personal ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+ALTER TABLE booking_personal ADD COLUMN IF NOT EXISTS closed_reason VARCHAR(255);
+
+CREATE TABLE IF NOT EXISTS booking_plan_changes (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL,
+  old_booking_id UUID NOT NULL REFERENCES booking_personal(id) ON DELETE CASCADE,
+  new_booking_id UUID NOT NULL REFERENCES booking_personal(id) ON DELETE CASCADE,
+  credit INT NOT NULL,
+  extra_payment INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS booking_plan_changes_old_booking_idx ON booking_plan_changes (old_booking_id);
+
+-- Closed bookings never grant access again
+CREATE OR REPLACE FUNCTION update_booking_personal_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_personal
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'personal'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_personal
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.closed_at IS NOT NULL THEN
+    NEW.access_status := 'closed';
+  ELSIF NEW.payment >= subscription_price AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,165 @@
+CREATE TABLE IF NOT EXISTS bundles (
+    id UUID PRIMARY KEY,
+    gym_id UUID REFERENCES sport_halls(id),
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    price INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at BIGINT DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS bundle_items (
+    bundle_id UUID NOT NULL REFERENCES bundles(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    subscription_type VARCHAR(20) NOT NULL,
+    subscription_id UUID NOT NULL,
+    PRIMARY KEY (bundle_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS bundle_purchases (
+    id UUID PRIMARY KEY,
+    bundle_id UUID NOT NULL REFERENCES bundles(id),
+    user_id UUID NOT NULL, -- REFERENCES users(id),
+    payment INT NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS bundle_purchases_user_idx ON bundle_purchases (user_id);
+
+ALTER TABLE booking_personal ADD COLUMN IF NOT EXISTS bundle_purchase_id UUID REFERENCES bundle_purchases(id);
+ALTER TABLE booking_group ADD COLUMN IF NOT EXISTS bundle_purchase_id UUID REFERENCES bundle_purchases(id);
+ALTER TABLE booking_coach ADD COLUMN IF NOT EXISTS bundle_purchase_id UUID REFERENCES bundle_purchases(id);
+
+CREATE INDEX IF NOT EXISTS booking_personal_bundle_purchase_idx ON booking_personal (bundle_purchase_id);
+CREATE INDEX IF NOT EXISTS booking_group_bundle_purchase_idx ON booking_group (bundle_purchase_id);
+CREATE INDEX IF NOT EXISTS booking_coach_bundle_purchase_idx ON booking_coach (bundle_purchase_id);
+
+-- A booking bought through a bundle is paid by the bundle, so its share of the
+-- bundle price may be below the plan's own price.
+CREATE OR REPLACE FUNCTION update_booking_personal_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_personal
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'personal'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_personal
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF NEW.closed_at IS NOT NULL THEN
+    NEW.access_status := 'closed';
+  ELSIF (NEW.bundle_purchase_id IS NOT NULL OR NEW.payment >= subscription_price) AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_group_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  subscription_count INT;
+  access_count INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_group
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price, duration and count of the pinned version
+  SELECT price, duration, count INTO STRICT subscription_price, subscription_duration, subscription_count
+  FROM subscription_versions
+  WHERE subscription_type = 'group'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the access count
+  SELECT COUNT(*) INTO access_count
+  FROM access_group
+  WHERE booking_id = NEW.id;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check all conditions
+  IF (NEW.bundle_purchase_id IS NOT NULL OR NEW.payment >= subscription_price) AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 day') > NOW()) AND
+     (NEW.count = -1 OR access_count < subscription_count) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_booking_coach_access()
+RETURNS TRIGGER AS $$
+DECLARE
+  subscription_price INT;
+  subscription_duration INT;
+  booking_start_date TIMESTAMP;
+BEGIN
+  -- Pin the current plan version if the booking has none yet
+  IF NEW.subscription_version IS NULL THEN
+    SELECT version INTO STRICT NEW.subscription_version
+    FROM subscription_coach
+    WHERE id = NEW.subscription_id;
+  END IF;
+
+  -- Get the price and duration of the pinned version
+  SELECT price, duration INTO STRICT subscription_price, subscription_duration
+  FROM subscription_versions
+  WHERE subscription_type = 'coach'
+    AND subscription_id = NEW.subscription_id
+    AND version = NEW.subscription_version;
+
+  -- Get the booking start date
+  booking_start_date := NEW.start_date;
+
+  -- Check if payment is sufficient and the booking has not ended yet
+  IF (NEW.bundle_purchase_id IS NOT NULL OR NEW.payment >= subscription_price) AND
+     (booking_start_date + (subscription_duration * INTERVAL '1 hour') > NOW()) THEN
+    NEW.access_status := 'granted';
+  ELSE
+    NEW.access_status := 'denied';
+  END IF;
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS subscription_time_windows;
+
+ALTER TABLE sport_halls DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,11 @@
+-- Time windows are evaluated in the gym's local time
+ALTER TABLE sport_halls ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+CREATE TABLE IF NOT EXISTS subscription_time_windows (
+    subscription_id UUID NOT NULL REFERENCES subscription_personal(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    CHECK (start_time < end_time),
+    PRIMARY KEY (subscription_id, weekday, start_time)
+);
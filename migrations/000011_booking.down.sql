@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS gender_overrides;
+
+ALTER TABLE users DROP COLUMN IF EXISTS gender;
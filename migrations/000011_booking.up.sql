@@ -0,0 +1,16 @@
+-- Member gender, checked against sport_halls.type_gender. Members without a
+-- recorded gender are not restricted.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS gender type_gender_enum;
+
+CREATE TABLE IF NOT EXISTS gender_overrides (
+    id UUID PRIMARY KEY,
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL, -- REFERENCES users(id),
+    granted_by UUID NOT NULL, -- REFERENCES users(id),
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP,
+    revoked_by UUID -- REFERENCES users(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS gender_overrides_active_idx ON gender_overrides (gym_id, user_id) WHERE revoked_at IS NULL;
@@ -0,0 +1,14 @@
+-- Turnstile entries and exits. Only counted entries use up a visit; repeated
+-- scans are logged with counted = FALSE.
+CREATE TABLE IF NOT EXISTS access_events (
+    id BIGSERIAL PRIMARY KEY,
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL, -- REFERENCES users(id),
+    booking_id UUID,
+    booking_type VARCHAR(20), -- "personal" or "pass"
+    direction VARCHAR(10) NOT NULL CHECK (direction IN ('entry', 'exit')),
+    counted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS access_events_user_gym_idx ON access_events (user_id, gym_id, id DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS access_events_gym_user_idx;
+
+ALTER TABLE sport_halls DROP COLUMN IF EXISTS max_occupancy;
@@ -0,0 +1,4 @@
+-- Fire-code limit on members inside a hall at once; NULL means no limit
+ALTER TABLE sport_halls ADD COLUMN IF NOT EXISTS max_occupancy INT CHECK (max_occupancy > 0);
+
+CREATE INDEX IF NOT EXISTS access_events_gym_user_idx ON access_events (gym_id, user_id, id DESC);
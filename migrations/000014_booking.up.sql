@@ -0,0 +1,11 @@
+-- Check-in tokens that have been scanned. A token may only be used once;
+-- rows are pruned after the token expires.
+CREATE TABLE IF NOT EXISTS checkin_tokens_used (
+    token_hash BYTEA PRIMARY KEY,
+    user_id UUID NOT NULL, -- REFERENCES users(id),
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS checkin_tokens_used_expires_idx ON checkin_tokens_used (expires_at);
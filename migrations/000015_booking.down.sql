@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_face_id_idx;
+
+DROP TABLE IF EXISTS face_checkin_attempts;
@@ -0,0 +1,17 @@
+-- Face-ID check-in attempts, accepted or not, for auditing recognition devices.
+CREATE TABLE IF NOT EXISTS face_checkin_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    device_id VARCHAR(255) NOT NULL,
+    face_id VARCHAR(255) NOT NULL,
+    confidence REAL NOT NULL,
+    user_id UUID, -- REFERENCES users(id), NULL when the face was not resolved
+    result VARCHAR(10) NOT NULL CHECK (result IN ('granted', 'denied')),
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS face_checkin_attempts_gym_idx ON face_checkin_attempts (gym_id, created_at);
+CREATE INDEX IF NOT EXISTS face_checkin_attempts_device_idx ON face_checkin_attempts (device_id, created_at);
+
+CREATE INDEX IF NOT EXISTS users_face_id_idx ON users (face_id) WHERE face_id IS NOT NULL AND deleted_at = 0;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS offline_entries;
+
+DROP TABLE IF EXISTS offline_access_snapshots;
@@ -0,0 +1,25 @@
+-- Credential lists exported to turnstile controllers for offline use. Each
+-- version stores the full list so deltas can be computed against it.
+CREATE TABLE IF NOT EXISTS offline_access_snapshots (
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    version BIGINT NOT NULL,
+    credentials BYTEA NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (gym_id, version)
+);
+
+-- Scans uploaded by controllers after working offline.
+CREATE TABLE IF NOT EXISTS offline_entries (
+    device_id VARCHAR(255) NOT NULL,
+    client_entry_id VARCHAR(255) NOT NULL,
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL, -- REFERENCES users(id),
+    booking_id UUID,
+    direction VARCHAR(10) NOT NULL CHECK (direction IN ('entry', 'exit')),
+    scanned_at TIMESTAMP NOT NULL,
+    over_limit BOOLEAN NOT NULL DEFAULT FALSE,
+    imported_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (device_id, client_entry_id)
+);
+
+CREATE INDEX IF NOT EXISTS offline_entries_gym_idx ON offline_entries (gym_id, scanned_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS access_events_gym_created_idx;
+DROP INDEX IF EXISTS access_events_user_created_idx;
+
+DELETE FROM access_events WHERE result = 'denied';
+
+ALTER TABLE access_events DROP COLUMN IF EXISTS reason;
+ALTER TABLE access_events DROP COLUMN IF EXISTS result;
@@ -0,0 +1,42 @@
+-- Denied attempts are logged in access_events alongside granted ones, so the
+-- table doubles as the access history of every booking type.
+ALTER TABLE access_events ADD COLUMN IF NOT EXISTS result VARCHAR(10) NOT NULL DEFAULT 'granted' CHECK (result IN ('granted', 'denied'));
+ALTER TABLE access_events ADD COLUMN IF NOT EXISTS reason TEXT;
+
+-- Backfill visits recorded before every access path logged an event
+INSERT INTO access_events (gym_id, user_id, booking_id, booking_type, direction, counted, created_at)
+SELECT s.gym_id, COALESCE(a.user_id, b.user_id), b.id, 'personal', 'entry', TRUE, a.date
+FROM access_personal a
+JOIN booking_personal b ON b.id = a.booking_id
+JOIN subscription_personal s ON s.id = b.subscription_id
+WHERE s.gym_id IS NOT NULL AND COALESCE(a.user_id, b.user_id) IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM access_events e
+    WHERE e.booking_id = b.id AND e.created_at BETWEEN a.date - INTERVAL '1 minute' AND a.date + INTERVAL '1 minute'
+)
+ORDER BY a.date;
+
+INSERT INTO access_events (gym_id, user_id, booking_id, booking_type, direction, counted, created_at)
+SELECT s.gym_id, COALESCE(a.user_id, b.user_id), b.id, 'group', 'entry', TRUE, a.date
+FROM access_group a
+JOIN booking_group b ON b.id = a.booking_id
+JOIN subscription_group s ON s.id = b.subscription_id
+WHERE s.gym_id IS NOT NULL AND COALESCE(a.user_id, b.user_id) IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM access_events e
+    WHERE e.booking_id = b.id AND e.created_at BETWEEN a.date - INTERVAL '1 minute' AND a.date + INTERVAL '1 minute'
+)
+ORDER BY a.date;
+
+INSERT INTO access_events (gym_id, user_id, booking_id, booking_type, direction, counted, created_at)
+SELECT s.gym_id, COALESCE(a.user_id, b.user_id), b.id, 'coach', 'entry', TRUE, a.date
+FROM access_coach a
+JOIN booking_coach b ON b.id = a.booking_id
+JOIN subscription_coach s ON s.id = b.subscription_id
+WHERE s.gym_id IS NOT NULL AND COALESCE(a.user_id, b.user_id) IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM access_events e
+    WHERE e.booking_id = b.id AND e.created_at BETWEEN a.date - INTERVAL '1 minute' AND a.date + INTERVAL '1 minute'
+)
+ORDER BY a.date;
+
+-- History by member and by gym over a date range
+CREATE INDEX IF NOT EXISTS access_events_user_created_idx ON access_events (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS access_events_gym_created_idx ON access_events (gym_id, created_at DESC);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS access_events_created_idx;
+DROP INDEX IF EXISTS access_events_device_idx;
+DROP INDEX IF EXISTS access_events_denied_idx;
+
+DELETE FROM access_events WHERE user_id IS NULL;
+ALTER TABLE access_events ALTER COLUMN user_id SET NOT NULL;
+ALTER TABLE access_events DROP COLUMN IF EXISTS credential_type;
+ALTER TABLE access_events DROP COLUMN IF EXISTS device_id;
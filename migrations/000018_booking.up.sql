@@ -0,0 +1,11 @@
+-- Every access attempt is audited with the reader that made it and how the
+-- member identified. Attempts with an unreadable credential have no user.
+ALTER TABLE access_events ADD COLUMN IF NOT EXISTS device_id VARCHAR(255);
+ALTER TABLE access_events ADD COLUMN IF NOT EXISTS credential_type VARCHAR(20);
+ALTER TABLE access_events ALTER COLUMN user_id DROP NOT NULL;
+
+-- Reviewing denials for suspicious patterns
+CREATE INDEX IF NOT EXISTS access_events_denied_idx ON access_events (gym_id, created_at) WHERE result = 'denied';
+CREATE INDEX IF NOT EXISTS access_events_device_idx ON access_events (device_id, created_at) WHERE device_id IS NOT NULL;
+-- Pruning by retention
+CREATE INDEX IF NOT EXISTS access_events_created_idx ON access_events (created_at);
@@ -0,0 +1,21 @@
+DO $$
+DECLARE
+    audited TEXT;
+BEGIN
+    FOREACH audited IN ARRAY ARRAY[
+        'booking_personal', 'booking_group', 'booking_coach',
+        'subscription_personal', 'subscription_group', 'subscription_coach',
+        'subscription_time_windows', 'subscription_transfer_rules',
+        'booking_members', 'booking_transfers',
+        'bundles', 'bundle_items', 'bundle_purchases',
+        'passes', 'gender_overrides', 'sport_halls'
+    ]
+    LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', audited || '_audit', audited);
+    END LOOP;
+END;
+$$;
+
+DROP FUNCTION IF EXISTS audit_row_change();
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_immutable();
@@ -0,0 +1,104 @@
+-- Full history of bookings, plans and the records hanging off them. Rows are
+-- written by triggers inside the transaction making the change, so a change
+-- cannot commit without its audit entry. The service tags each transaction
+-- with the caller through the athlevo.* settings.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(64) NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    actor VARCHAR(255),
+    rpc VARCHAR(255),
+    request_id VARCHAR(255),
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id, id);
+
+-- The log is append-only
+CREATE OR REPLACE FUNCTION audit_log_immutable() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_immutable ON audit_log;
+CREATE TRIGGER audit_log_immutable
+    BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_immutable();
+
+-- audit_row_change records one row change. The first trigger argument names
+-- the column identifying the entity, "id" by default.
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS trigger AS $$
+DECLARE
+    id_column TEXT := COALESCE(TG_ARGV[0], 'id');
+    old_row JSONB;
+    new_row JSONB;
+    change_action TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        change_action := 'create';
+        new_row := to_jsonb(NEW);
+    ELSIF TG_OP = 'UPDATE' THEN
+        change_action := 'update';
+        old_row := to_jsonb(OLD);
+        new_row := to_jsonb(NEW);
+        -- Touching updated_at alone is not a change
+        IF (old_row - 'updated_at') = (new_row - 'updated_at') THEN
+            RETURN NULL;
+        END IF;
+    ELSE
+        change_action := 'delete';
+        old_row := to_jsonb(OLD);
+    END IF;
+
+    INSERT INTO audit_log (entity_type, entity_id, action, actor, rpc, request_id, before, after)
+    VALUES (
+        TG_TABLE_NAME,
+        COALESCE(new_row, old_row) ->> id_column,
+        change_action,
+        NULLIF(current_setting('athlevo.actor', true), ''),
+        NULLIF(current_setting('athlevo.rpc', true), ''),
+        NULLIF(current_setting('athlevo.request_id', true), ''),
+        old_row,
+        new_row
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+    audited RECORD;
+BEGIN
+    FOR audited IN
+        SELECT * FROM (VALUES
+            ('booking_personal', 'id'),
+            ('booking_group', 'id'),
+            ('booking_coach', 'id'),
+            ('subscription_personal', 'id'),
+            ('subscription_group', 'id'),
+            ('subscription_coach', 'id'),
+            ('subscription_time_windows', 'subscription_id'),
+            ('subscription_transfer_rules', 'subscription_id'),
+            ('booking_members', 'booking_id'),
+            ('booking_transfers', 'id'),
+            ('bundles', 'id'),
+            ('bundle_items', 'bundle_id'),
+            ('bundle_purchases', 'id'),
+            ('passes', 'id'),
+            ('gender_overrides', 'id'),
+            ('sport_halls', 'id')
+        ) AS t (table_name, id_column)
+    LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', audited.table_name || '_audit', audited.table_name);
+        EXECUTE format(
+            'CREATE TRIGGER %I AFTER INSERT OR UPDATE OR DELETE ON %I FOR EACH ROW EXECUTE FUNCTION audit_row_change(%L)',
+            audited.table_name || '_audit', audited.table_name, audited.id_column
+        );
+    END LOOP;
+END;
+$$;
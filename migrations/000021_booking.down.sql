@@ -0,0 +1,3 @@
+ALTER TABLE outbox DROP COLUMN IF EXISTS gym_id;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
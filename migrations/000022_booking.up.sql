@@ -0,0 +1,23 @@
+-- Reminders for members. dedupe_key identifies what a reminder is about, so
+-- each plan or class is reminded of once per kind.
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    dedupe_key VARCHAR(255) NOT NULL,
+    language VARCHAR(255) NOT NULL DEFAULT 'en',
+    booking_id UUID,
+    gym_id UUID NOT NULL,
+    occurs_at TIMESTAMPTZ,
+    visits_left INT,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    send_after TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ,
+    UNIQUE (kind, dedupe_key)
+);
+
+CREATE INDEX IF NOT EXISTS notifications_due_idx ON notifications (send_after) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS notifications_user_id_idx ON notifications (user_id, created_at);
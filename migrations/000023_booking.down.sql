@@ -0,0 +1 @@
+DROP INDEX IF EXISTS access_events_denied_created_idx;
@@ -0,0 +1,2 @@
+-- Only denied attempts expire; granted events are the attendance history
+CREATE INDEX IF NOT EXISTS access_events_denied_created_idx ON access_events (created_at) WHERE result = 'denied';
@@ -0,0 +1,9 @@
+-- Turnstile controllers allowed to upload offline scans. Each device signs
+-- its uploads with its own key, which is only returned when it is registered.
+CREATE TABLE IF NOT EXISTS offline_devices (
+    device_id VARCHAR(255) PRIMARY KEY,
+    gym_id UUID NOT NULL REFERENCES sport_halls(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS access_events_gym_user_idx;
+CREATE INDEX IF NOT EXISTS access_events_gym_user_idx ON access_events (gym_id, user_id, id DESC);
+
+DROP INDEX IF EXISTS access_events_user_gym_idx;
+CREATE INDEX IF NOT EXISTS access_events_user_gym_idx ON access_events (user_id, gym_id, id DESC);
@@ -0,0 +1,7 @@
+-- Passback and occupancy read each member's last scan by scan time, since
+-- visits recorded later may be back-dated.
+DROP INDEX IF EXISTS access_events_user_gym_idx;
+CREATE INDEX IF NOT EXISTS access_events_user_gym_idx ON access_events (user_id, gym_id, created_at DESC, id DESC);
+
+DROP INDEX IF EXISTS access_events_gym_user_idx;
+CREATE INDEX IF NOT EXISTS access_events_gym_user_idx ON access_events (gym_id, user_id, created_at DESC, id DESC);
@@ -0,0 +1,47 @@
+-- audit_row_change now takes, after the column identifying the entity, the
+-- columns kept out of the log, such as secrets.
+CREATE OR REPLACE FUNCTION audit_row_change() RETURNS trigger AS $$
+DECLARE
+    id_column TEXT := COALESCE(TG_ARGV[0], 'id');
+    omitted TEXT[] := COALESCE(TG_ARGV[1:TG_NARGS - 1], '{}');
+    old_row JSONB;
+    new_row JSONB;
+    change_action TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        change_action := 'create';
+        new_row := to_jsonb(NEW) - omitted;
+    ELSIF TG_OP = 'UPDATE' THEN
+        change_action := 'update';
+        old_row := to_jsonb(OLD) - omitted;
+        new_row := to_jsonb(NEW) - omitted;
+        -- Touching updated_at alone is not a change
+        IF (old_row - 'updated_at') = (new_row - 'updated_at') THEN
+            RETURN NULL;
+        END IF;
+    ELSE
+        change_action := 'delete';
+        old_row := to_jsonb(OLD) - omitted;
+    END IF;
+
+    INSERT INTO audit_log (entity_type, entity_id, action, actor, rpc, request_id, before, after)
+    VALUES (
+        TG_TABLE_NAME,
+        COALESCE(new_row, old_row) ->> id_column,
+        change_action,
+        NULLIF(current_setting('athlevo.actor', true), ''),
+        NULLIF(current_setting('athlevo.rpc', true), ''),
+        NULLIF(current_setting('athlevo.request_id', true), ''),
+        old_row,
+        new_row
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Endpoint changes join the audit log, without the signing secret
+DROP TRIGGER IF EXISTS webhook_endpoints_audit ON webhook_endpoints;
+CREATE TRIGGER webhook_endpoints_audit
+    AFTER INSERT OR UPDATE OR DELETE ON webhook_endpoints
+    FOR EACH ROW EXECUTE FUNCTION audit_row_change('id', 'secret');
@@ -0,0 +1,9 @@
+-- Keep only the windows of each plan's current version
+ALTER TABLE subscription_time_windows DROP CONSTRAINT IF EXISTS subscription_time_windows_pkey;
+
+DELETE FROM subscription_time_windows w
+USING subscription_personal sp
+WHERE sp.id = w.subscription_id AND w.version <> sp.version;
+
+ALTER TABLE subscription_time_windows DROP COLUMN IF EXISTS version;
+ALTER TABLE subscription_time_windows ADD PRIMARY KEY (subscription_id, weekday, start_time);
@@ -0,0 +1,17 @@
+-- Windows belong to a plan version, so bookings keep the hours they were
+-- sold with when the plan changes. Until now a plan's windows applied to
+-- every version, so each version gets a copy of them.
+ALTER TABLE subscription_time_windows DROP CONSTRAINT IF EXISTS subscription_time_windows_pkey;
+ALTER TABLE subscription_time_windows ADD COLUMN IF NOT EXISTS version INT;
+
+INSERT INTO subscription_time_windows (subscription_id, version, weekday, start_time, end_time)
+SELECT w.subscription_id, v.version, w.weekday, w.start_time, w.end_time
+FROM subscription_time_windows w
+JOIN subscription_versions v ON v.subscription_type = 'personal'
+    AND v.subscription_id = w.subscription_id
+WHERE w.version IS NULL;
+
+DELETE FROM subscription_time_windows WHERE version IS NULL;
+
+ALTER TABLE subscription_time_windows ALTER COLUMN version SET NOT NULL;
+ALTER TABLE subscription_time_windows ADD PRIMARY KEY (subscription_id, version, weekday, start_time);
@@ -0,0 +1,2 @@
+ALTER TABLE users DROP COLUMN IF EXISTS gender_set_at;
+ALTER TABLE users DROP COLUMN IF EXISTS gender_set_by;
@@ -0,0 +1,4 @@
+-- Staff record member gender through SetMemberGender; members without a
+-- recorded gender are not restricted until then.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS gender_set_by UUID; -- REFERENCES users(id)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS gender_set_at TIMESTAMP;
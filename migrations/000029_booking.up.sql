@@ -0,0 +1,17 @@
+-- Keys of events written at most once, such as a booking's expiry. They are
+-- kept apart from the outbox so pruning published events cannot let one be
+-- written again.
+CREATE TABLE IF NOT EXISTS outbox_dedupe (
+    dedupe_key VARCHAR(255) PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Bookings whose period ended before expiries were swept are not announced
+INSERT INTO outbox_dedupe (dedupe_key)
+SELECT 'subscription.expired:personal:' || bp.id
+FROM booking_personal bp
+JOIN subscription_versions v ON v.subscription_type = 'personal'
+    AND v.subscription_id = bp.subscription_id
+    AND v.version = bp.subscription_version
+WHERE bp.start_date + v.duration * INTERVAL '1 day' <= NOW()
+ON CONFLICT DO NOTHING;
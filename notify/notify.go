@@ -0,0 +1,147 @@
+// Package notify reminds members about their plans and classes: when a plan
+// is about to end or run out of visits, and before a group class starts.
+// Reminders are rendered in the member's language and sent through a
+// Channel. Group classes have no waitlist yet, so there is no reminder for a
+// waitlist promotion.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Reminder kinds.
+const (
+	KindPlanExpiring  = "plan_expiring"
+	KindVisitsLeft    = "visits_left"
+	KindClassStarting = "class_starting"
+)
+
+// defaultLanguage is used for members without a language setting, and for
+// languages without translations.
+const defaultLanguage = "en"
+
+// Reminder is a notification due to be sent to a member.
+type Reminder struct {
+	ID         string
+	UserID     string
+	Kind       string
+	Language   string
+	BookingID  string
+	OccursAt   time.Time // when the plan ends or the class starts
+	Timezone   string    // the gym's timezone, used to show OccursAt
+	VisitsLeft int32
+	Attempts   int32 // attempts made before this one
+}
+
+// Message is a rendered reminder.
+type Message struct {
+	UserID string
+	Kind   string
+	Title  string
+	Body   string
+}
+
+// Channel delivers messages to members, e.g. by push or SMS.
+type Channel interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogChannel writes messages to a logger instead of sending them, for local
+// runs and tests. It keeps every message it was given.
+type LogChannel struct {
+	logger *slog.Logger
+
+	mu   sync.Mutex
+	sent []Message
+}
+
+// NewLogChannel creates a LogChannel writing to logger.
+func NewLogChannel(logger *slog.Logger) *LogChannel {
+	return &LogChannel{logger: logger}
+}
+
+// Send logs msg.
+func (c *LogChannel) Send(ctx context.Context, msg Message) error {
+	c.logger.InfoContext(ctx, "notification", "kind", msg.Kind, "user_id", msg.UserID, "title", msg.Title, "body", msg.Body)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sent = append(c.sent, msg)
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first.
+func (c *LogChannel) Sent() []Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Message(nil), c.sent...)
+}
+
+// template holds the title and body format of a reminder in one language.
+type template struct {
+	title string
+	body  string
+}
+
+// templates holds each reminder kind by language. Plan expiry bodies take
+// the end date, visit bodies the number of visits left and class bodies the
+// start time.
+var templates = map[string]map[string]template{
+	KindPlanExpiring: {
+		"en": {"Your plan ends soon", "Your plan ends on %s. Renew it to keep your access."},
+		"ru": {"Ваш абонемент скоро закончится", "Ваш абонемент действует до %s. Продлите его, чтобы сохранить доступ."},
+		"uz": {"Obunangiz tez orada tugaydi", "Obunangiz %s kuni tugaydi. Kirish huquqini saqlash uchun uni uzaytiring."},
+	},
+	KindVisitsLeft: {
+		"en": {"Visits running out", "You have %d visits left on your plan."},
+		"ru": {"Посещения заканчиваются", "На вашем абонементе осталось посещений: %d."},
+		"uz": {"Tashriflar tugamoqda", "Obunangizda %d ta tashrif qoldi."},
+	},
+	KindClassStarting: {
+		"en": {"Class starts soon", "Your class starts at %s."},
+		"ru": {"Скоро начнётся занятие", "Ваше занятие начинается в %s."},
+		"uz": {"Mashg'ulot tez orada boshlanadi", "Mashg'ulotingiz soat %s da boshlanadi."},
+	},
+}
+
+// Render builds the message for a reminder in the member's language, with
+// times shown on the gym's clock.
+func Render(r *Reminder) (Message, error) {
+	byLanguage, ok := templates[r.Kind]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown reminder kind %q", r.Kind)
+	}
+	t, ok := byLanguage[r.Language]
+	if !ok {
+		t = byLanguage[defaultLanguage]
+	}
+
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	occursAt := r.OccursAt.In(loc)
+
+	var body string
+	switch r.Kind {
+	case KindPlanExpiring:
+		body = fmt.Sprintf(t.body, occursAt.Format("2006-01-02"))
+	case KindVisitsLeft:
+		body = fmt.Sprintf(t.body, r.VisitsLeft)
+	case KindClassStarting:
+		body = fmt.Sprintf(t.body, occursAt.Format("15:04"))
+	}
+
+	return Message{
+		UserID: r.UserID,
+		Kind:   r.Kind,
+		Title:  t.title,
+		Body:   body,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// sendBatchSize is how many reminders are claimed at a time.
+const sendBatchSize = 50
+
+// sendLease hides claimed reminders from other schedulers while they are sent.
+const sendLease = 5 * time.Minute
+
+// Rules decide which reminders are created.
+type Rules struct {
+	ExpiryNotice time.Duration // how long before a plan ends to remind its holder
+	VisitsLeft   int32         // remind when this many visits or fewer are left
+	ClassNotice  time.Duration // how long before a group class to remind its members
+}
+
+// Outcome is the result of sending a reminder.
+type Outcome struct {
+	Error   string    // empty when the reminder was sent
+	RetryAt time.Time // when to try again; zero when no retries are left
+}
+
+// Store holds reminders.
+type Store interface {
+	// ScheduleReminders creates the reminders the rules call for, at most
+	// once per plan or class, for members who have not turned notifications
+	// off. It returns how many were created.
+	ScheduleReminders(ctx context.Context, rules Rules) (int, error)
+	// ClaimReminders returns up to limit reminders due to be sent and hides
+	// them from other claims for lease.
+	ClaimReminders(ctx context.Context, limit int, lease time.Duration) ([]*Reminder, error)
+	// RecordOutcome stores the result of sending a reminder.
+	RecordOutcome(ctx context.Context, id string, outcome Outcome) error
+}
+
+// Scheduler creates reminders and sends them through a channel. A reminder
+// that fails to send is retried after retryDelay until maxAttempts attempts
+// have failed.
+type Scheduler struct {
+	store       Store
+	channel     Channel
+	rules       Rules
+	maxAttempts int32
+	retryDelay  time.Duration
+	logger      *slog.Logger
+}
+
+// NewScheduler creates a Scheduler.
+func NewScheduler(store Store, channel Channel, rules Rules, maxAttempts int32, retryDelay time.Duration, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		store:       store,
+		channel:     channel,
+		rules:       rules,
+		maxAttempts: maxAttempts,
+		retryDelay:  retryDelay,
+		logger:      logger,
+	}
+}
+
+// Run schedules and sends reminders every interval until ctx is done. A
+// tick under way when ctx is done is finished before Run returns.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	work := context.WithoutCancel(ctx)
+	for {
+		if _, err := s.Tick(work); err != nil {
+			s.logger.ErrorContext(ctx, "error sending reminders", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick creates the reminders that are due and sends them, returning how
+// many were sent.
+func (s *Scheduler) Tick(ctx context.Context) (int, error) {
+	if _, err := s.store.ScheduleReminders(ctx, s.rules); err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for {
+		batch, err := s.store.ClaimReminders(ctx, sendBatchSize, sendLease)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, reminder := range batch {
+			outcome := s.send(ctx, reminder)
+			if outcome.Error == "" {
+				sent++
+			}
+			if err := s.store.RecordOutcome(ctx, reminder.ID, outcome); err != nil {
+				return sent, err
+			}
+		}
+
+		if len(batch) < sendBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+// send renders and sends a reminder and decides whether to retry it.
+func (s *Scheduler) send(ctx context.Context, reminder *Reminder) Outcome {
+	msg, err := Render(reminder)
+	if err == nil {
+		err = s.channel.Send(ctx, msg)
+	}
+	if err == nil {
+		return Outcome{}
+	}
+
+	outcome := Outcome{Error: err.Error()}
+	if reminder.Attempts+1 < s.maxAttempts {
+		outcome.RetryAt = time.Now().Add(s.retryDelay)
+	}
+	return outcome
+}
@@ -0,0 +1,102 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryStore hands out a fixed set of reminders once and keeps outcomes.
+type memoryStore struct {
+	due      []*Reminder
+	outcomes map[string]Outcome
+}
+
+func (s *memoryStore) ScheduleReminders(ctx context.Context, rules Rules) (int, error) {
+	return 0, nil
+}
+
+func (s *memoryStore) ClaimReminders(ctx context.Context, limit int, lease time.Duration) ([]*Reminder, error) {
+	claimed := s.due
+	s.due = nil
+	return claimed, nil
+}
+
+func (s *memoryStore) RecordOutcome(ctx context.Context, id string, outcome Outcome) error {
+	if s.outcomes == nil {
+		s.outcomes = make(map[string]Outcome)
+	}
+	s.outcomes[id] = outcome
+	return nil
+}
+
+// failingChannel fails every send.
+type failingChannel struct{}
+
+func (failingChannel) Send(ctx context.Context, msg Message) error {
+	return errors.New("gateway unavailable")
+}
+
+func TestRender(t *testing.T) {
+	occursAt := time.Date(2024, 3, 10, 21, 30, 0, 0, time.UTC)
+
+	t.Run("UsesMemberLanguage", func(t *testing.T) {
+		msg, err := Render(&Reminder{Kind: KindVisitsLeft, Language: "ru", VisitsLeft: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, "Посещения заканчиваются", msg.Title)
+		assert.Contains(t, msg.Body, "2")
+	})
+
+	t.Run("FallsBackToEnglish", func(t *testing.T) {
+		msg, err := Render(&Reminder{Kind: KindPlanExpiring, Language: "de", OccursAt: occursAt, Timezone: "UTC"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Your plan ends on 2024-03-10. Renew it to keep your access.", msg.Body)
+	})
+
+	t.Run("ShowsGymLocalTime", func(t *testing.T) {
+		msg, err := Render(&Reminder{Kind: KindClassStarting, Language: "en", OccursAt: occursAt, Timezone: "Asia/Tashkent"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Your class starts at 02:30.", msg.Body)
+	})
+
+	t.Run("UnknownKind", func(t *testing.T) {
+		_, err := Render(&Reminder{Kind: "no_such_kind"})
+		assert.Error(t, err)
+	})
+}
+
+func TestScheduler(t *testing.T) {
+	rules := Rules{ExpiryNotice: 72 * time.Hour, VisitsLeft: 2, ClassNotice: time.Hour}
+
+	t.Run("SendsThroughChannel", func(t *testing.T) {
+		store := &memoryStore{due: []*Reminder{{ID: "r1", UserID: "u1", Kind: KindVisitsLeft, Language: "en", VisitsLeft: 1}}}
+		channel := NewLogChannel(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+		sent, err := NewScheduler(store, channel, rules, 3, time.Minute, slog.Default()).Tick(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		if assert.Len(t, channel.Sent(), 1) {
+			assert.Equal(t, "u1", channel.Sent()[0].UserID)
+		}
+		assert.Empty(t, store.outcomes["r1"].Error)
+	})
+
+	t.Run("FailedSendIsRetriedThenGivenUp", func(t *testing.T) {
+		store := &memoryStore{due: []*Reminder{
+			{ID: "first", Kind: KindVisitsLeft, Attempts: 0},
+			{ID: "last", Kind: KindVisitsLeft, Attempts: 2},
+		}}
+
+		sent, err := NewScheduler(store, failingChannel{}, rules, 3, time.Minute, slog.Default()).Tick(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		assert.NotEmpty(t, store.outcomes["first"].Error)
+		assert.False(t, store.outcomes["first"].RetryAt.IsZero())
+		assert.True(t, store.outcomes["last"].RetryAt.IsZero())
+	})
+}
@@ -0,0 +1,60 @@
+// Package pubsub provides an in-process publish/subscribe broker.
+package pubsub
+
+import "sync"
+
+// Broker fans out published values to every current subscriber. Publishing
+// never blocks: a subscriber whose buffer is full is dropped and its channel
+// closed, so it can tell it missed values and subscribe again.
+type Broker[T any] struct {
+	mu     sync.Mutex
+	subs   map[chan T]struct{}
+	buffer int
+}
+
+// NewBroker creates a Broker whose subscribers buffer up to buffer values.
+func NewBroker[T any](buffer int) *Broker[T] {
+	return &Broker[T]{
+		subs:   make(map[chan T]struct{}),
+		buffer: buffer,
+	}
+}
+
+// Subscribe returns a channel receiving every value published from now on,
+// and a function that ends the subscription. The channel is closed when the
+// subscription ends.
+func (b *Broker[T]) Subscribe() (<-chan T, func()) {
+	ch := make(chan T, b.buffer)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(ch)
+	}
+}
+
+// Publish sends v to every subscriber.
+func (b *Broker[T]) Publish(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- v:
+		default:
+			b.remove(ch)
+		}
+	}
+}
+
+// remove ends a subscription. The caller must hold b.mu.
+func (b *Broker[T]) remove(ch chan T) {
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,51 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	t.Run("PublishReachesEverySubscriber", func(t *testing.T) {
+		broker := NewBroker[int](1)
+
+		first, cancelFirst := broker.Subscribe()
+		defer cancelFirst()
+		second, cancelSecond := broker.Subscribe()
+		defer cancelSecond()
+
+		broker.Publish(1)
+
+		assert.Equal(t, 1, <-first)
+		assert.Equal(t, 1, <-second)
+	})
+
+	t.Run("SlowSubscriberIsDropped", func(t *testing.T) {
+		broker := NewBroker[int](1)
+
+		ch, cancel := broker.Subscribe()
+		defer cancel()
+
+		broker.Publish(1)
+		broker.Publish(2)
+
+		assert.Equal(t, 1, <-ch)
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("CancelClosesChannel", func(t *testing.T) {
+		broker := NewBroker[int](1)
+
+		ch, cancel := broker.Subscribe()
+		cancel()
+		cancel()
+
+		_, ok := <-ch
+		assert.False(t, ok)
+
+		// Publishing with no subscribers is a no-op
+		broker.Publish(1)
+	})
+}
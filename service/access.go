@@ -2,22 +2,26 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AccessService implements the gRPC server for access-related operations.
 type AccessService struct {
 	storage                                  storage.StorageI
+	logger                                   *slog.Logger
 	booking.UnimplementedAccessServiceServer // Embed the unimplemented server
 }
 
 // NewAccessService creates a new AccessService instance.
-func NewAccessService(storage storage.StorageI) *AccessService {
+func NewAccessService(storage storage.StorageI, logger *slog.Logger) *AccessService {
 	return &AccessService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +29,7 @@ func NewAccessService(storage storage.StorageI) *AccessService {
 func (s *AccessService) CreateAccessPersonal(ctx context.Context, req *booking.CreateAccessPersonalRequest) (*booking.AccessPersonal, error) {
 	access, err := s.storage.Access().CreateAccessPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create personal access record: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create personal access record")
 	}
 	return access, nil
 }
@@ -34,7 +38,7 @@ func (s *AccessService) CreateAccessPersonal(ctx context.Context, req *booking.C
 func (s *AccessService) ListAccessPersonal(ctx context.Context, req *booking.ListAccessPersonalRequest) (*booking.ListAccessPersonalResponse, error) {
 	accesses, err := s.storage.Access().ListAccessPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list personal access records: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list personal access records")
 	}
 	return accesses, nil
 }
@@ -43,7 +47,7 @@ func (s *AccessService) ListAccessPersonal(ctx context.Context, req *booking.Lis
 func (s *AccessService) CreateAccessGroup(ctx context.Context, req *booking.CreateAccessGroupRequest) (*booking.AccessGroup, error) {
 	access, err := s.storage.Access().CreateAccessGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create group access record: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create group access record")
 	}
 	return access, nil
 }
@@ -52,7 +56,7 @@ func (s *AccessService) CreateAccessGroup(ctx context.Context, req *booking.Crea
 func (s *AccessService) ListAccessGroup(ctx context.Context, req *booking.ListAccessGroupRequest) (*booking.ListAccessGroupResponse, error) {
 	accesses, err := s.storage.Access().ListAccessGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list group access records: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list group access records")
 	}
 	return accesses, nil
 }
@@ -61,7 +65,7 @@ func (s *AccessService) ListAccessGroup(ctx context.Context, req *booking.ListAc
 func (s *AccessService) CreateAccessCoach(ctx context.Context, req *booking.CreateAccessCoachRequest) (*booking.AccessCoach, error) {
 	access, err := s.storage.Access().CreateAccessCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create coach access record: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create coach access record")
 	}
 	return access, nil
 }
@@ -70,7 +74,124 @@ func (s *AccessService) CreateAccessCoach(ctx context.Context, req *booking.Crea
 func (s *AccessService) ListAccessCoach(ctx context.Context, req *booking.ListAccessCoachRequest) (*booking.ListAccessCoachResponse, error) {
 	accesses, err := s.storage.Access().ListAccessCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list coach access records: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list coach access records")
 	}
 	return accesses, nil
 }
+
+// accessEventReplayPage is how many stored events are read per query when a
+// client resumes StreamAccessEvents from a cursor.
+const accessEventReplayPage = 100
+
+// accessEventReplayOverlap is how many event IDs before the client's cursor
+// are replayed again on resume. IDs are taken when an event is inserted but
+// the event only appears once its transaction commits, so one with a lower
+// ID can appear after the client has seen a higher one.
+const accessEventReplayOverlap = 100
+
+// accessEventSentWindow is how many sent event IDs a stream remembers, to
+// drop events it has already sent from the replay and the live feed.
+const accessEventSentWindow = 1000
+
+// sentEvents remembers the IDs of the events most recently sent on a stream.
+type sentEvents struct {
+	ids   map[int64]struct{}
+	order []int64
+}
+
+func newSentEvents() *sentEvents {
+	return &sentEvents{ids: make(map[int64]struct{})}
+}
+
+// add records id and reports whether it had not been sent yet.
+func (s *sentEvents) add(id int64) bool {
+	if _, ok := s.ids[id]; ok {
+		return false
+	}
+	s.ids[id] = struct{}{}
+	s.order = append(s.order, id)
+	if len(s.order) > accessEventSentWindow {
+		delete(s.ids, s.order[0])
+		s.order = s.order[1:]
+	}
+	return true
+}
+
+// StreamAccessEvents handles the StreamAccessEvents gRPC request. It replays
+// the events recorded after req.AfterId, then sends new events as they are
+// recorded until the client goes away. The replay starts a little before
+// req.AfterId, so clients may see an event again and de-duplicate by ID.
+func (s *AccessService) StreamAccessEvents(req *booking.StreamAccessEventsRequest, stream booking.AccessService_StreamAccessEventsServer) error {
+	ctx := stream.Context()
+	s.logger.InfoContext(ctx, "access event stream opened",
+		"gym_id", req.GymId,
+		"user_id", req.UserId,
+		"booking_type", req.BookingType,
+		"after_id", req.AfterId,
+	)
+
+	// Watch before replaying so no event recorded in between is missed
+	updates := s.storage.Access().WatchAccessEvents(ctx, req)
+
+	// Events are tracked by ID rather than by the highest ID sent, so an
+	// event committed after one with a higher ID is still sent
+	sent := newSentEvents()
+	lastID := req.AfterId
+	if req.AfterId > 0 {
+		cursor := max(req.AfterId-accessEventReplayOverlap, 0)
+		for {
+			events, err := s.storage.Access().ReplayAccessEvents(ctx, req, cursor, accessEventReplayPage)
+			if err != nil {
+				return statusError(ctx, s.logger, err, "failed to replay access events")
+			}
+			for _, event := range events {
+				cursor = event.Id
+				if !sent.add(event.Id) {
+					continue
+				}
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+				lastID = max(lastID, event.Id)
+			}
+			if len(events) < accessEventReplayPage {
+				break
+			}
+		}
+	}
+
+	for event := range updates {
+		// Skip events already sent during the replay
+		if !sent.add(event.Id) {
+			continue
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		lastID = max(lastID, event.Id)
+	}
+
+	if ctx.Err() != nil {
+		s.logger.InfoContext(ctx, "access event stream closed", "last_id", lastID)
+		return nil
+	}
+	return status.Errorf(codes.Aborted, "access event stream fell behind, reconnect with after_id %d to resume", lastID)
+}
+
+// ListAccessHistory handles the ListAccessHistory gRPC request.
+func (s *AccessService) ListAccessHistory(ctx context.Context, req *booking.ListAccessHistoryRequest) (*booking.ListAccessHistoryResponse, error) {
+	history, err := s.storage.Access().ListAccessHistory(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list access history")
+	}
+	return history, nil
+}
+
+// ListSuspiciousAccess handles the ListSuspiciousAccess gRPC request.
+func (s *AccessService) ListSuspiciousAccess(ctx context.Context, req *booking.ListSuspiciousAccessRequest) (*booking.ListSuspiciousAccessResponse, error) {
+	patterns, err := s.storage.Access().ListSuspiciousAccess(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list suspicious access")
+	}
+	return patterns, nil
+}
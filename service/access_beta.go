@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -13,13 +13,15 @@ import (
 // AccessServiceBeta implements the gRPC server for access beta-related operations.
 type AccessServiceBeta struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedAccessServiceBetaServer
 }
 
 // NewAccessServiceBeta creates a new AccessServiceBeta instance.
-func NewAccessServiceBeta(storage storage.StorageI) *AccessServiceBeta {
+func NewAccessServiceBeta(storage storage.StorageI, logger *slog.Logger) *AccessServiceBeta {
 	return &AccessServiceBeta{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -27,7 +29,43 @@ func NewAccessServiceBeta(storage storage.StorageI) *AccessServiceBeta {
 func (s *AccessServiceBeta) CheckUserAccess(ctx context.Context, req *booking.AccessBetaPersonalRequest) (*booking.AccessBetaPersonalResponse, error) {
 	response, err := s.storage.AccessBeta().CheckUserAccess(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check user access: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to check user access")
+	}
+	return response, nil
+}
+
+// CheckUserExit handles the CheckUserExit gRPC request for Access Beta Personal.
+func (s *AccessServiceBeta) CheckUserExit(ctx context.Context, req *booking.AccessBetaPersonalRequest) (*booking.AccessBetaPersonalResponse, error) {
+	response, err := s.storage.AccessBeta().CheckUserExit(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to check user exit")
+	}
+	return response, nil
+}
+
+// IssueCheckInToken handles the IssueCheckInToken gRPC request.
+func (s *AccessServiceBeta) IssueCheckInToken(ctx context.Context, req *booking.IssueCheckInTokenRequest) (*booking.CheckInToken, error) {
+	token, err := s.storage.AccessBeta().IssueCheckInToken(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to issue check-in token")
+	}
+	return token, nil
+}
+
+// CheckInWithToken handles the CheckInWithToken gRPC request.
+func (s *AccessServiceBeta) CheckInWithToken(ctx context.Context, req *booking.CheckInWithTokenRequest) (*booking.AccessBetaPersonalResponse, error) {
+	response, err := s.storage.AccessBeta().CheckInWithToken(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to check in with token")
+	}
+	return response, nil
+}
+
+// CheckInWithFace handles the CheckInWithFace gRPC request.
+func (s *AccessServiceBeta) CheckInWithFace(ctx context.Context, req *booking.FaceCheckInRequest) (*booking.AccessBetaPersonalResponse, error) {
+	response, err := s.storage.AccessBeta().CheckInWithFace(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to check in with face")
 	}
 	return response, nil
 }
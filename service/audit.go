@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// AuditService implements the gRPC server for the entity audit log.
+type AuditService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedAuditServiceServer
+}
+
+// NewAuditService creates a new AuditService instance.
+func NewAuditService(storage storage.StorageI, logger *slog.Logger) *AuditService {
+	return &AuditService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// GetEntityHistory handles the GetEntityHistory gRPC request.
+func (s *AuditService) GetEntityHistory(ctx context.Context, req *booking.GetEntityHistoryRequest) (*booking.GetEntityHistoryResponse, error) {
+	history, err := s.storage.Audit().GetEntityHistory(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get entity history")
+	}
+	return history, nil
+}
@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -11,13 +11,15 @@ import (
 // BookingCoachService implements the gRPC server for access-related operations.
 type BookingCoachService struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedBookingCoachServiceServer
 }
 
 // NewBookingCoachService creates a new BookingCoachService instance.
-func NewBookingCoachService(storage storage.StorageI) *BookingCoachService {
+func NewBookingCoachService(storage storage.StorageI, logger *slog.Logger) *BookingCoachService {
 	return &BookingCoachService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +27,7 @@ func NewBookingCoachService(storage storage.StorageI) *BookingCoachService {
 func (s *BookingCoachService) CreateBookingCoach(ctx context.Context, req *booking.CreateBookingCoachRequest) (*booking.BookingCoach, error) {
 	booking, err := s.storage.BookingCoach().CreateBookingCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create coach booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create coach booking")
 	}
 	return booking, nil
 }
@@ -34,7 +36,7 @@ func (s *BookingCoachService) CreateBookingCoach(ctx context.Context, req *booki
 func (s *BookingCoachService) GetBookingCoach(ctx context.Context, req *booking.GetBookingCoachRequest) (*booking.BookingCoach, error) {
 	booking, err := s.storage.BookingCoach().GetBookingCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get coach booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to get coach booking")
 	}
 	return booking, nil
 }
@@ -43,7 +45,7 @@ func (s *BookingCoachService) GetBookingCoach(ctx context.Context, req *booking.
 func (s *BookingCoachService) UpdateBookingCoach(ctx context.Context, req *booking.UpdateBookingCoachRequest) (*booking.BookingCoach, error) {
 	booking, err := s.storage.BookingCoach().UpdateBookingCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update coach booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to update coach booking")
 	}
 	return booking, nil
 }
@@ -52,7 +54,7 @@ func (s *BookingCoachService) UpdateBookingCoach(ctx context.Context, req *booki
 func (s *BookingCoachService) DeleteBookingCoach(ctx context.Context, req *booking.DeleteBookingCoachRequest) (*booking.Empty, error) {
 	err := s.storage.BookingCoach().DeleteBookingCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete coach booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to delete coach booking")
 	}
 	return &booking.Empty{}, nil
 }
@@ -61,7 +63,7 @@ func (s *BookingCoachService) DeleteBookingCoach(ctx context.Context, req *booki
 func (s *BookingCoachService) ListBookingCoach(ctx context.Context, req *booking.ListBookingCoachRequest) (*booking.ListBookingCoachResponse, error) {
 	bookings, err := s.storage.BookingCoach().ListBookingCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list coach bookings: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list coach bookings")
 	}
 	return bookings, nil
 }
@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -11,13 +11,15 @@ import (
 // BookingGroupService implements the gRPC server for booking group-related operations.
 type BookingGroupService struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedBookingGroupServiceServer
 }
 
 // NewBookingGroupService creates a new BookingGroupService instance.
-func NewBookingGroupService(storage storage.StorageI) *BookingGroupService {
+func NewBookingGroupService(storage storage.StorageI, logger *slog.Logger) *BookingGroupService {
 	return &BookingGroupService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +27,7 @@ func NewBookingGroupService(storage storage.StorageI) *BookingGroupService {
 func (s *BookingGroupService) CreateBookingGroup(ctx context.Context, req *booking.CreateBookingGroupRequest) (*booking.BookingGroup, error) {
 	booking, err := s.storage.BookingGroup().CreateBookingGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create group booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create group booking")
 	}
 	return booking, nil
 }
@@ -34,7 +36,7 @@ func (s *BookingGroupService) CreateBookingGroup(ctx context.Context, req *booki
 func (s *BookingGroupService) GetBookingGroup(ctx context.Context, req *booking.GetBookingGroupRequest) (*booking.BookingGroup, error) {
 	booking, err := s.storage.BookingGroup().GetBookingGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get group booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to get group booking")
 	}
 	return booking, nil
 }
@@ -43,7 +45,7 @@ func (s *BookingGroupService) GetBookingGroup(ctx context.Context, req *booking.
 func (s *BookingGroupService) UpdateBookingGroup(ctx context.Context, req *booking.UpdateBookingGroupRequest) (*booking.BookingGroup, error) {
 	booking, err := s.storage.BookingGroup().UpdateBookingGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update group booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to update group booking")
 	}
 	return booking, nil
 }
@@ -52,7 +54,7 @@ func (s *BookingGroupService) UpdateBookingGroup(ctx context.Context, req *booki
 func (s *BookingGroupService) DeleteBookingGroup(ctx context.Context, req *booking.DeleteBookingGroupRequest) (*booking.Empty, error) {
 	err := s.storage.BookingGroup().DeleteBookingGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete group booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to delete group booking")
 	}
 	return &booking.Empty{}, nil
 }
@@ -61,7 +63,7 @@ func (s *BookingGroupService) DeleteBookingGroup(ctx context.Context, req *booki
 func (s *BookingGroupService) ListBookingGroup(ctx context.Context, req *booking.ListBookingGroupRequest) (*booking.ListBookingGroupResponse, error) {
 	bookings, err := s.storage.BookingGroup().ListBookingGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list group bookings: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list group bookings")
 	}
 	return bookings, nil
 }
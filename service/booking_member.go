@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// BookingMemberService implements the gRPC server for shared booking members.
+type BookingMemberService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedBookingMemberServiceServer
+}
+
+// NewBookingMemberService creates a new BookingMemberService instance.
+func NewBookingMemberService(storage storage.StorageI, logger *slog.Logger) *BookingMemberService {
+	return &BookingMemberService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// AddBookingMember handles the AddBookingMember gRPC request.
+func (s *BookingMemberService) AddBookingMember(ctx context.Context, req *booking.AddBookingMemberRequest) (*booking.BookingMember, error) {
+	member, err := s.storage.BookingMember().AddBookingMember(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to add booking member")
+	}
+	return member, nil
+}
+
+// RemoveBookingMember handles the RemoveBookingMember gRPC request.
+func (s *BookingMemberService) RemoveBookingMember(ctx context.Context, req *booking.RemoveBookingMemberRequest) (*booking.Empty, error) {
+	err := s.storage.BookingMember().RemoveBookingMember(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to remove booking member")
+	}
+	return &booking.Empty{}, nil
+}
+
+// ListBookingMembers handles the ListBookingMembers gRPC request.
+func (s *BookingMemberService) ListBookingMembers(ctx context.Context, req *booking.ListBookingMembersRequest) (*booking.ListBookingMembersResponse, error) {
+	members, err := s.storage.BookingMember().ListBookingMembers(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list booking members")
+	}
+	return members, nil
+}
@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -11,13 +11,15 @@ import (
 // BookingPersonalService implements the gRPC server for booking personal-related operations.
 type BookingPersonalService struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedBookingPersonalServiceServer
 }
 
 // NewBookingPersonalService creates a new BookingPersonalService instance.
-func NewBookingPersonalService(storage storage.StorageI) *BookingPersonalService {
+func NewBookingPersonalService(storage storage.StorageI, logger *slog.Logger) *BookingPersonalService {
 	return &BookingPersonalService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +27,7 @@ func NewBookingPersonalService(storage storage.StorageI) *BookingPersonalService
 func (s *BookingPersonalService) CreateBookingPersonal(ctx context.Context, req *booking.CreateBookingPersonalRequest) (*booking.BookingPersonal, error) {
 	booking, err := s.storage.BookingPersonal().CreateBookingPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create personal booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create personal booking")
 	}
 	return booking, nil
 }
@@ -34,7 +36,7 @@ func (s *BookingPersonalService) CreateBookingPersonal(ctx context.Context, req
 func (s *BookingPersonalService) GetBookingPersonal(ctx context.Context, req *booking.GetBookingPersonalRequest) (*booking.BookingPersonal, error) {
 	booking, err := s.storage.BookingPersonal().GetBookingPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get personal booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to get personal booking")
 	}
 	return booking, nil
 }
@@ -43,7 +45,7 @@ func (s *BookingPersonalService) GetBookingPersonal(ctx context.Context, req *bo
 func (s *BookingPersonalService) UpdateBookingPersonal(ctx context.Context, req *booking.UpdateBookingPersonalRequest) (*booking.BookingPersonal, error) {
 	booking, err := s.storage.BookingPersonal().UpdateBookingPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update personal booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to update personal booking")
 	}
 	return booking, nil
 }
@@ -52,7 +54,7 @@ func (s *BookingPersonalService) UpdateBookingPersonal(ctx context.Context, req
 func (s *BookingPersonalService) DeleteBookingPersonal(ctx context.Context, req *booking.DeleteBookingPersonalRequest) (*booking.Empty, error) {
 	err := s.storage.BookingPersonal().DeleteBookingPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete personal booking: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to delete personal booking")
 	}
 	return &booking.Empty{}, nil
 }
@@ -61,7 +63,16 @@ func (s *BookingPersonalService) DeleteBookingPersonal(ctx context.Context, req
 func (s *BookingPersonalService) ListBookingPersonal(ctx context.Context, req *booking.ListBookingPersonalRequest) (*booking.ListBookingPersonalResponse, error) {
 	bookings, err := s.storage.BookingPersonal().ListBookingPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list personal bookings: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list personal bookings")
 	}
 	return bookings, nil
 }
+
+// ChangePlan handles the ChangePlan gRPC request.
+func (s *BookingPersonalService) ChangePlan(ctx context.Context, req *booking.ChangePlanRequest) (*booking.ChangePlanResponse, error) {
+	resp, err := s.storage.BookingPersonal().ChangePlan(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to change plan")
+	}
+	return resp, nil
+}
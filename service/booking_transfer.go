@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// BookingTransferService implements the gRPC server for booking transfers.
+type BookingTransferService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedBookingTransferServiceServer
+}
+
+// NewBookingTransferService creates a new BookingTransferService instance.
+func NewBookingTransferService(storage storage.StorageI, logger *slog.Logger) *BookingTransferService {
+	return &BookingTransferService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// SetTransferRule handles the SetTransferRule gRPC request.
+func (s *BookingTransferService) SetTransferRule(ctx context.Context, req *booking.SetTransferRuleRequest) (*booking.TransferRule, error) {
+	rule, err := s.storage.BookingTransfer().SetTransferRule(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to set transfer rule")
+	}
+	return rule, nil
+}
+
+// GetTransferRule handles the GetTransferRule gRPC request.
+func (s *BookingTransferService) GetTransferRule(ctx context.Context, req *booking.GetTransferRuleRequest) (*booking.TransferRule, error) {
+	rule, err := s.storage.BookingTransfer().GetTransferRule(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get transfer rule")
+	}
+	return rule, nil
+}
+
+// TransferBooking handles the TransferBooking gRPC request.
+func (s *BookingTransferService) TransferBooking(ctx context.Context, req *booking.TransferBookingRequest) (*booking.BookingTransfer, error) {
+	transfer, err := s.storage.BookingTransfer().TransferBooking(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to transfer booking")
+	}
+	return transfer, nil
+}
+
+// ListBookingTransfers handles the ListBookingTransfers gRPC request.
+func (s *BookingTransferService) ListBookingTransfers(ctx context.Context, req *booking.ListBookingTransfersRequest) (*booking.ListBookingTransfersResponse, error) {
+	transfers, err := s.storage.BookingTransfer().ListBookingTransfers(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list booking transfers")
+	}
+	return transfers, nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// BundleService implements the gRPC server for subscription bundle operations.
+type BundleService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedBundleServiceServer
+}
+
+// NewBundleService creates a new BundleService instance.
+func NewBundleService(storage storage.StorageI, logger *slog.Logger) *BundleService {
+	return &BundleService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// CreateBundle handles the CreateBundle gRPC request.
+func (s *BundleService) CreateBundle(ctx context.Context, req *booking.CreateBundleRequest) (*booking.Bundle, error) {
+	bundle, err := s.storage.Bundle().CreateBundle(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to create bundle")
+	}
+	return bundle, nil
+}
+
+// GetBundle handles the GetBundle gRPC request.
+func (s *BundleService) GetBundle(ctx context.Context, req *booking.GetBundleRequest) (*booking.Bundle, error) {
+	bundle, err := s.storage.Bundle().GetBundle(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get bundle")
+	}
+	return bundle, nil
+}
+
+// DeleteBundle handles the DeleteBundle gRPC request.
+func (s *BundleService) DeleteBundle(ctx context.Context, req *booking.DeleteBundleRequest) (*booking.Empty, error) {
+	err := s.storage.Bundle().DeleteBundle(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to delete bundle")
+	}
+	return &booking.Empty{}, nil
+}
+
+// ListBundles handles the ListBundles gRPC request.
+func (s *BundleService) ListBundles(ctx context.Context, req *booking.ListBundlesRequest) (*booking.ListBundlesResponse, error) {
+	bundles, err := s.storage.Bundle().ListBundles(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list bundles")
+	}
+	return bundles, nil
+}
+
+// PurchaseBundle handles the PurchaseBundle gRPC request.
+func (s *BundleService) PurchaseBundle(ctx context.Context, req *booking.PurchaseBundleRequest) (*booking.BundlePurchase, error) {
+	purchase, err := s.storage.Bundle().PurchaseBundle(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to purchase bundle")
+	}
+	return purchase, nil
+}
+
+// GetBundlePurchase handles the GetBundlePurchase gRPC request.
+func (s *BundleService) GetBundlePurchase(ctx context.Context, req *booking.GetBundlePurchaseRequest) (*booking.BundlePurchase, error) {
+	purchase, err := s.storage.Bundle().GetBundlePurchase(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get bundle purchase")
+	}
+	return purchase, nil
+}
+
+// ListBundlePurchases handles the ListBundlePurchases gRPC request.
+func (s *BundleService) ListBundlePurchases(ctx context.Context, req *booking.ListBundlePurchasesRequest) (*booking.ListBundlePurchasesResponse, error) {
+	purchases, err := s.storage.Bundle().ListBundlePurchases(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list bundle purchases")
+	}
+	return purchases, nil
+}
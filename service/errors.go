@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusError returns err as a gRPC status, prefixed with msg, whose code
+// says whether the caller or the service is at fault. The gateway turns the
+// code into the matching HTTP status. Internal errors are logged with their
+// cause and reach the caller as msg alone, so database details stay in the
+// logs.
+func statusError(ctx context.Context, logger *slog.Logger, err error, msg string) error {
+	code := errorCode(err)
+	if code == codes.Internal {
+		logger.ErrorContext(ctx, msg, "error", err)
+		return status.Error(code, msg)
+	}
+	return status.Error(code, fmt.Sprintf("%s: %v", msg, err))
+}
+
+// errorCode picks the gRPC code for an error returned by the storage layer.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return codes.NotFound
+	case errors.Is(err, storage.ErrInvalidArgument):
+		return codes.InvalidArgument
+	case errors.Is(err, storage.ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, storage.ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, storage.ErrFailedPrecondition):
+		return codes.FailedPrecondition
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation
+			return codes.AlreadyExists
+		case "23503": // foreign_key_violation
+			return codes.FailedPrecondition
+		case "23502", "23514", "22P02", "22007", "22008": // not_null_violation, check_violation, bad input syntax or date
+			return codes.InvalidArgument
+		}
+	}
+
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return codes.Internal
+}
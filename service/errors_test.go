@@ -0,0 +1,52 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: fmt.Errorf("error getting booking: %w", pgx.ErrNoRows), code: codes.NotFound},
+		{err: storage.Errorf(storage.ErrInvalidArgument, "gym_id and device_id are required"), code: codes.InvalidArgument},
+		{err: storage.Errorf(storage.ErrUnauthenticated, "check-in tokens are issued to the calling member"), code: codes.Unauthenticated},
+		{err: storage.Errorf(storage.ErrPermissionDenied, "only the account holder can change the plan"), code: codes.PermissionDenied},
+		{err: fmt.Errorf("error checking access: %w", storage.Errorf(storage.ErrFailedPrecondition, "access denied")), code: codes.FailedPrecondition},
+		{err: storage.Errorf(storage.ErrAlreadyExists, "trial pass already issued for this gym"), code: codes.AlreadyExists},
+		{err: &pgconn.PgError{Code: "23505"}, code: codes.AlreadyExists},
+		{err: &pgconn.PgError{Code: "22P02"}, code: codes.InvalidArgument},
+		{err: fmt.Errorf("error listing: %w", context.Canceled), code: codes.Canceled},
+		{err: fmt.Errorf("connection refused"), code: codes.Internal},
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	for _, tt := range tests {
+		buf.Reset()
+		err := statusError(context.Background(), logger, tt.err, "failed to do it")
+		assert.Equal(t, tt.code, status.Code(err), tt.err.Error())
+
+		if tt.code == codes.Internal {
+			// The cause is logged, not returned
+			assert.Equal(t, "failed to do it", status.Convert(err).Message())
+			assert.Contains(t, buf.String(), tt.err.Error())
+		} else {
+			assert.Equal(t, "failed to do it: "+tt.err.Error(), status.Convert(err).Message())
+			assert.Empty(t, buf.String())
+		}
+	}
+}
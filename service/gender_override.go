@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// GenderOverrideService implements the gRPC server for gender-restricted hall overrides.
+type GenderOverrideService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedGenderOverrideServiceServer
+}
+
+// NewGenderOverrideService creates a new GenderOverrideService instance.
+func NewGenderOverrideService(storage storage.StorageI, logger *slog.Logger) *GenderOverrideService {
+	return &GenderOverrideService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// GrantGenderOverride handles the GrantGenderOverride gRPC request.
+func (s *GenderOverrideService) GrantGenderOverride(ctx context.Context, req *booking.GrantGenderOverrideRequest) (*booking.GenderOverride, error) {
+	override, err := s.storage.GenderOverride().GrantGenderOverride(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to grant gender override")
+	}
+	return override, nil
+}
+
+// RevokeGenderOverride handles the RevokeGenderOverride gRPC request.
+func (s *GenderOverrideService) RevokeGenderOverride(ctx context.Context, req *booking.RevokeGenderOverrideRequest) (*booking.Empty, error) {
+	err := s.storage.GenderOverride().RevokeGenderOverride(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to revoke gender override")
+	}
+	return &booking.Empty{}, nil
+}
+
+// ListGenderOverrides handles the ListGenderOverrides gRPC request.
+func (s *GenderOverrideService) ListGenderOverrides(ctx context.Context, req *booking.ListGenderOverridesRequest) (*booking.ListGenderOverridesResponse, error) {
+	overrides, err := s.storage.GenderOverride().ListGenderOverrides(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list gender overrides")
+	}
+	return overrides, nil
+}
+
+// SetMemberGender handles the SetMemberGender gRPC request.
+func (s *GenderOverrideService) SetMemberGender(ctx context.Context, req *booking.SetMemberGenderRequest) (*booking.MemberGender, error) {
+	gender, err := s.storage.GenderOverride().SetMemberGender(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to set member gender")
+	}
+	return gender, nil
+}
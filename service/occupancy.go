@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OccupancyService implements the gRPC server for live hall occupancy.
+type OccupancyService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedOccupancyServiceServer
+}
+
+// NewOccupancyService creates a new OccupancyService instance.
+func NewOccupancyService(storage storage.StorageI, logger *slog.Logger) *OccupancyService {
+	return &OccupancyService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// GetOccupancy handles the GetOccupancy gRPC request.
+func (s *OccupancyService) GetOccupancy(ctx context.Context, req *booking.GetOccupancyRequest) (*booking.Occupancy, error) {
+	occupancy, err := s.storage.Occupancy().GetOccupancy(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get occupancy")
+	}
+	return occupancy, nil
+}
+
+// SetMaxOccupancy handles the SetMaxOccupancy gRPC request.
+func (s *OccupancyService) SetMaxOccupancy(ctx context.Context, req *booking.SetMaxOccupancyRequest) (*booking.Occupancy, error) {
+	occupancy, err := s.storage.Occupancy().SetMaxOccupancy(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to set max occupancy")
+	}
+	return occupancy, nil
+}
+
+// StreamOccupancy handles the StreamOccupancy gRPC request. It sends the
+// current occupancy, then every change until the client goes away.
+func (s *OccupancyService) StreamOccupancy(req *booking.StreamOccupancyRequest, stream booking.OccupancyService_StreamOccupancyServer) error {
+	ctx := stream.Context()
+	s.logger.InfoContext(ctx, "occupancy stream opened", "gym_id", req.GymId)
+
+	// Watch before reading the current value so no change is missed
+	updates := s.storage.Occupancy().WatchOccupancy(ctx, req.GymId)
+
+	occupancy, err := s.storage.Occupancy().GetOccupancy(ctx, &booking.GetOccupancyRequest{GymId: req.GymId})
+	if err != nil {
+		return statusError(ctx, s.logger, err, "failed to get occupancy")
+	}
+	if err := stream.Send(occupancy); err != nil {
+		return err
+	}
+
+	for occupancy := range updates {
+		if err := stream.Send(occupancy); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		s.logger.InfoContext(ctx, "occupancy stream closed", "gym_id", req.GymId)
+		return nil
+	}
+	return status.Error(codes.Aborted, "occupancy stream fell behind, reconnect to resume")
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// OfflineAccessService implements the gRPC server for offline turnstile controllers.
+type OfflineAccessService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedOfflineAccessServiceServer
+}
+
+// NewOfflineAccessService creates a new OfflineAccessService instance.
+func NewOfflineAccessService(storage storage.StorageI, logger *slog.Logger) *OfflineAccessService {
+	return &OfflineAccessService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// ExportOfflineAccess handles the ExportOfflineAccess gRPC request.
+func (s *OfflineAccessService) ExportOfflineAccess(ctx context.Context, req *booking.ExportOfflineAccessRequest) (*booking.OfflineAccessSnapshot, error) {
+	snapshot, err := s.storage.OfflineAccess().ExportOfflineAccess(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to export offline access")
+	}
+	return snapshot, nil
+}
+
+// ImportOfflineEntries handles the ImportOfflineEntries gRPC request.
+func (s *OfflineAccessService) ImportOfflineEntries(ctx context.Context, req *booking.ImportOfflineEntriesRequest) (*booking.ImportOfflineEntriesResponse, error) {
+	resp, err := s.storage.OfflineAccess().ImportOfflineEntries(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to import offline entries")
+	}
+	return resp, nil
+}
+
+// RegisterOfflineDevice handles the RegisterOfflineDevice gRPC request.
+func (s *OfflineAccessService) RegisterOfflineDevice(ctx context.Context, req *booking.RegisterOfflineDeviceRequest) (*booking.OfflineDevice, error) {
+	device, err := s.storage.OfflineAccess().RegisterOfflineDevice(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to register offline device")
+	}
+	return device, nil
+}
+
+// RevokeOfflineDevice handles the RevokeOfflineDevice gRPC request.
+func (s *OfflineAccessService) RevokeOfflineDevice(ctx context.Context, req *booking.RevokeOfflineDeviceRequest) (*booking.Empty, error) {
+	err := s.storage.OfflineAccess().RevokeOfflineDevice(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to revoke offline device")
+	}
+	return &booking.Empty{}, nil
+}
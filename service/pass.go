@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// PassService implements the gRPC server for trial and guest pass operations.
+type PassService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedPassServiceServer
+}
+
+// NewPassService creates a new PassService instance.
+func NewPassService(storage storage.StorageI, logger *slog.Logger) *PassService {
+	return &PassService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// IssuePass handles the IssuePass gRPC request.
+func (s *PassService) IssuePass(ctx context.Context, req *booking.IssuePassRequest) (*booking.Pass, error) {
+	pass, err := s.storage.Pass().IssuePass(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to issue pass")
+	}
+	return pass, nil
+}
+
+// GetPass handles the GetPass gRPC request.
+func (s *PassService) GetPass(ctx context.Context, req *booking.GetPassRequest) (*booking.Pass, error) {
+	pass, err := s.storage.Pass().GetPass(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get pass")
+	}
+	return pass, nil
+}
+
+// ListPasses handles the ListPasses gRPC request.
+func (s *PassService) ListPasses(ctx context.Context, req *booking.ListPassesRequest) (*booking.ListPassesResponse, error) {
+	passes, err := s.storage.Pass().ListPasses(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list passes")
+	}
+	return passes, nil
+}
+
+// RevokePass handles the RevokePass gRPC request.
+func (s *PassService) RevokePass(ctx context.Context, req *booking.RevokePassRequest) (*booking.Empty, error) {
+	err := s.storage.Pass().RevokePass(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to revoke pass")
+	}
+	return &booking.Empty{}, nil
+}
+
+// GetTrialConversionReport handles the GetTrialConversionReport gRPC request.
+func (s *PassService) GetTrialConversionReport(ctx context.Context, req *booking.TrialConversionReportRequest) (*booking.TrialConversionReport, error) {
+	report, err := s.storage.Pass().GetTrialConversionReport(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to get trial conversion report")
+	}
+	return report, nil
+}
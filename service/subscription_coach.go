@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -11,13 +11,15 @@ import (
 // SubscriptionCoachService implements the gRPC server for subscription coach-related operations.
 type SubscriptionCoachService struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedSubscriptionCoachServiceServer
 }
 
 // NewSubscriptionCoachService creates a new SubscriptionCoachService instance.
-func NewSubscriptionCoachService(storage storage.StorageI) *SubscriptionCoachService {
+func NewSubscriptionCoachService(storage storage.StorageI, logger *slog.Logger) *SubscriptionCoachService {
 	return &SubscriptionCoachService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +27,7 @@ func NewSubscriptionCoachService(storage storage.StorageI) *SubscriptionCoachSer
 func (s *SubscriptionCoachService) CreateSubscriptionCoach(ctx context.Context, req *booking.CreateSubscriptionCoachRequest) (*booking.SubscriptionCoach, error) {
 	subscription, err := s.storage.SubscriptionCoach().CreateSubscriptionCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create coach subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create coach subscription")
 	}
 	return subscription, nil
 }
@@ -34,7 +36,7 @@ func (s *SubscriptionCoachService) CreateSubscriptionCoach(ctx context.Context,
 func (s *SubscriptionCoachService) GetSubscriptionCoach(ctx context.Context, req *booking.GetSubscriptionCoachRequest) (*booking.SubscriptionCoach, error) {
 	subscription, err := s.storage.SubscriptionCoach().GetSubscriptionCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get coach subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to get coach subscription")
 	}
 	return subscription, nil
 }
@@ -43,7 +45,7 @@ func (s *SubscriptionCoachService) GetSubscriptionCoach(ctx context.Context, req
 func (s *SubscriptionCoachService) UpdateSubscriptionCoach(ctx context.Context, req *booking.UpdateSubscriptionCoachRequest) (*booking.SubscriptionCoach, error) {
 	subscription, err := s.storage.SubscriptionCoach().UpdateSubscriptionCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update coach subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to update coach subscription")
 	}
 	return subscription, nil
 }
@@ -52,7 +54,7 @@ func (s *SubscriptionCoachService) UpdateSubscriptionCoach(ctx context.Context,
 func (s *SubscriptionCoachService) DeleteSubscriptionCoach(ctx context.Context, req *booking.DeleteSubscriptionCoachRequest) (*booking.Empty, error) {
 	err := s.storage.SubscriptionCoach().DeleteSubscriptionCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete coach subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to delete coach subscription")
 	}
 	return &booking.Empty{}, nil
 }
@@ -61,7 +63,16 @@ func (s *SubscriptionCoachService) DeleteSubscriptionCoach(ctx context.Context,
 func (s *SubscriptionCoachService) ListSubscriptionCoach(ctx context.Context, req *booking.ListSubscriptionCoachRequest) (*booking.ListSubscriptionCoachResponse, error) {
 	subscriptions, err := s.storage.SubscriptionCoach().ListSubscriptionCoach(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list coach subscriptions: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list coach subscriptions")
 	}
 	return subscriptions, nil
 }
+
+// ListSubscriptionCoachVersions handles the ListSubscriptionCoachVersions gRPC request.
+func (s *SubscriptionCoachService) ListSubscriptionCoachVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error) {
+	versions, err := s.storage.SubscriptionCoach().ListSubscriptionCoachVersions(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list coach subscription versions")
+	}
+	return versions, nil
+}
@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -11,13 +11,15 @@ import (
 // SubscriptionGroupService implements the gRPC server for subscription group-related operations.
 type SubscriptionGroupService struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedSubscriptionGroupServiceServer
 }
 
 // NewSubscriptionGroupService creates a new SubscriptionGroupService instance.
-func NewSubscriptionGroupService(storage storage.StorageI) *SubscriptionGroupService {
+func NewSubscriptionGroupService(storage storage.StorageI, logger *slog.Logger) *SubscriptionGroupService {
 	return &SubscriptionGroupService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +27,7 @@ func NewSubscriptionGroupService(storage storage.StorageI) *SubscriptionGroupSer
 func (s *SubscriptionGroupService) CreateSubscriptionGroup(ctx context.Context, req *booking.CreateSubscriptionGroupRequest) (*booking.SubscriptionGroup, error) {
 	subscription, err := s.storage.SubscriptionGroup().CreateSubscriptionGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create group subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create group subscription")
 	}
 	return subscription, nil
 }
@@ -34,7 +36,7 @@ func (s *SubscriptionGroupService) CreateSubscriptionGroup(ctx context.Context,
 func (s *SubscriptionGroupService) GetSubscriptionGroup(ctx context.Context, req *booking.GetSubscriptionGroupRequest) (*booking.SubscriptionGroup, error) {
 	subscription, err := s.storage.SubscriptionGroup().GetSubscriptionGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get group subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to get group subscription")
 	}
 	return subscription, nil
 }
@@ -43,7 +45,7 @@ func (s *SubscriptionGroupService) GetSubscriptionGroup(ctx context.Context, req
 func (s *SubscriptionGroupService) UpdateSubscriptionGroup(ctx context.Context, req *booking.UpdateSubscriptionGroupRequest) (*booking.SubscriptionGroup, error) {
 	subscription, err := s.storage.SubscriptionGroup().UpdateSubscriptionGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update group subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to update group subscription")
 	}
 	return subscription, nil
 }
@@ -52,7 +54,7 @@ func (s *SubscriptionGroupService) UpdateSubscriptionGroup(ctx context.Context,
 func (s *SubscriptionGroupService) DeleteSubscriptionGroup(ctx context.Context, req *booking.DeleteSubscriptionGroupRequest) (*booking.Empty, error) {
 	err := s.storage.SubscriptionGroup().DeleteSubscriptionGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete group subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to delete group subscription")
 	}
 	return &booking.Empty{}, nil
 }
@@ -61,7 +63,16 @@ func (s *SubscriptionGroupService) DeleteSubscriptionGroup(ctx context.Context,
 func (s *SubscriptionGroupService) ListSubscriptionGroup(ctx context.Context, req *booking.ListSubscriptionGroupRequest) (*booking.ListSubscriptionGroupResponse, error) {
 	subscriptions, err := s.storage.SubscriptionGroup().ListSubscriptionGroup(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list group subscriptions: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list group subscriptions")
 	}
 	return subscriptions, nil
 }
+
+// ListSubscriptionGroupVersions handles the ListSubscriptionGroupVersions gRPC request.
+func (s *SubscriptionGroupService) ListSubscriptionGroupVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error) {
+	versions, err := s.storage.SubscriptionGroup().ListSubscriptionGroupVersions(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list group subscription versions")
+	}
+	return versions, nil
+}
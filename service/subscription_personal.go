@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage"
@@ -11,13 +11,15 @@ import (
 // SubscriptionPersonalService implements the gRPC server for subscription personal-related operations.
 type SubscriptionPersonalService struct {
 	storage storage.StorageI
+	logger  *slog.Logger
 	booking.UnimplementedSubscriptionPersonalServiceServer
 }
 
 // NewSubscriptionPersonalService creates a new SubscriptionPersonalService instance.
-func NewSubscriptionPersonalService(storage storage.StorageI) *SubscriptionPersonalService {
+func NewSubscriptionPersonalService(storage storage.StorageI, logger *slog.Logger) *SubscriptionPersonalService {
 	return &SubscriptionPersonalService{
 		storage: storage,
+		logger:  logger,
 	}
 }
 
@@ -25,7 +27,7 @@ func NewSubscriptionPersonalService(storage storage.StorageI) *SubscriptionPerso
 func (s *SubscriptionPersonalService) CreateSubscriptionPersonal(ctx context.Context, req *booking.CreateSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error) {
 	subscription, err := s.storage.SubscriptionPersonal().CreateSubscriptionPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create personal subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to create personal subscription")
 	}
 	return subscription, nil
 }
@@ -34,7 +36,7 @@ func (s *SubscriptionPersonalService) CreateSubscriptionPersonal(ctx context.Con
 func (s *SubscriptionPersonalService) GetSubscriptionPersonal(ctx context.Context, req *booking.GetSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error) {
 	subscription, err := s.storage.SubscriptionPersonal().GetSubscriptionPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get personal subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to get personal subscription")
 	}
 	return subscription, nil
 }
@@ -43,7 +45,7 @@ func (s *SubscriptionPersonalService) GetSubscriptionPersonal(ctx context.Contex
 func (s *SubscriptionPersonalService) UpdateSubscriptionPersonal(ctx context.Context, req *booking.UpdateSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error) {
 	subscription, err := s.storage.SubscriptionPersonal().UpdateSubscriptionPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update personal subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to update personal subscription")
 	}
 	return subscription, nil
 }
@@ -52,7 +54,7 @@ func (s *SubscriptionPersonalService) UpdateSubscriptionPersonal(ctx context.Con
 func (s *SubscriptionPersonalService) DeleteSubscriptionPersonal(ctx context.Context, req *booking.DeleteSubscriptionPersonalRequest) (*booking.Empty, error) {
 	err := s.storage.SubscriptionPersonal().DeleteSubscriptionPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete personal subscription: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to delete personal subscription")
 	}
 	return &booking.Empty{}, nil
 }
@@ -61,7 +63,16 @@ func (s *SubscriptionPersonalService) DeleteSubscriptionPersonal(ctx context.Con
 func (s *SubscriptionPersonalService) ListSubscriptionPersonal(ctx context.Context, req *booking.ListSubscriptionPersonalRequest) (*booking.ListSubscriptionPersonalResponse, error) {
 	subscriptions, err := s.storage.SubscriptionPersonal().ListSubscriptionPersonal(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list personal subscriptions: %w", err)
+		return nil, statusError(ctx, s.logger, err, "failed to list personal subscriptions")
 	}
 	return subscriptions, nil
 }
+
+// ListSubscriptionPersonalVersions handles the ListSubscriptionPersonalVersions gRPC request.
+func (s *SubscriptionPersonalService) ListSubscriptionPersonalVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error) {
+	versions, err := s.storage.SubscriptionPersonal().ListSubscriptionPersonalVersions(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list personal subscription versions")
+	}
+	return versions, nil
+}
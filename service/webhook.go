@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// WebhookService implements the gRPC server for gym webhooks.
+type WebhookService struct {
+	storage storage.StorageI
+	logger  *slog.Logger
+	booking.UnimplementedWebhookServiceServer
+}
+
+// NewWebhookService creates a new WebhookService instance.
+func NewWebhookService(storage storage.StorageI, logger *slog.Logger) *WebhookService {
+	return &WebhookService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// CreateWebhookEndpoint handles the CreateWebhookEndpoint gRPC request.
+func (s *WebhookService) CreateWebhookEndpoint(ctx context.Context, req *booking.CreateWebhookEndpointRequest) (*booking.WebhookEndpoint, error) {
+	endpoint, err := s.storage.Webhook().CreateWebhookEndpoint(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to create webhook endpoint")
+	}
+	return endpoint, nil
+}
+
+// UpdateWebhookEndpoint handles the UpdateWebhookEndpoint gRPC request.
+func (s *WebhookService) UpdateWebhookEndpoint(ctx context.Context, req *booking.UpdateWebhookEndpointRequest) (*booking.WebhookEndpoint, error) {
+	endpoint, err := s.storage.Webhook().UpdateWebhookEndpoint(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to update webhook endpoint")
+	}
+	return endpoint, nil
+}
+
+// DeleteWebhookEndpoint handles the DeleteWebhookEndpoint gRPC request.
+func (s *WebhookService) DeleteWebhookEndpoint(ctx context.Context, req *booking.DeleteWebhookEndpointRequest) (*booking.Empty, error) {
+	err := s.storage.Webhook().DeleteWebhookEndpoint(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to delete webhook endpoint")
+	}
+	return &booking.Empty{}, nil
+}
+
+// ListWebhookEndpoints handles the ListWebhookEndpoints gRPC request.
+func (s *WebhookService) ListWebhookEndpoints(ctx context.Context, req *booking.ListWebhookEndpointsRequest) (*booking.ListWebhookEndpointsResponse, error) {
+	endpoints, err := s.storage.Webhook().ListWebhookEndpoints(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list webhook endpoints")
+	}
+	return endpoints, nil
+}
+
+// ListWebhookDeliveries handles the ListWebhookDeliveries gRPC request.
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *booking.ListWebhookDeliveriesRequest) (*booking.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.storage.Webhook().ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to list webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// ReplayWebhookDeliveries handles the ReplayWebhookDeliveries gRPC request.
+func (s *WebhookService) ReplayWebhookDeliveries(ctx context.Context, req *booking.ReplayWebhookDeliveriesRequest) (*booking.ReplayWebhookDeliveriesResponse, error) {
+	replayed, err := s.storage.Webhook().ReplayWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, statusError(ctx, s.logger, err, "failed to replay webhook deliveries")
+	}
+	return replayed, nil
+}
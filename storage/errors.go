@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of error the repos return for requests that cannot succeed as sent,
+// so the services can answer with a matching gRPC status code. Match them
+// with errors.Is.
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrAlreadyExists      = errors.New("already exists")
+)
+
+// Errorf formats an error of the given kind. Its message is only the
+// formatted text.
+func Errorf(kind error, format string, args ...any) error {
+	return &kindError{kind: kind, msg: fmt.Sprintf(format, args...)}
+}
+
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
@@ -3,55 +3,102 @@ package postgres
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // AccessRepo implements the AccessRepoI interface for Access entities.
 type AccessRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	events *pubsub.Broker[*booking.AccessEvent]
+	logger *slog.Logger
 }
 
-// NewAccessRepo creates a new AccessRepo.
-func NewAccessRepo(db *pgx.Conn) *AccessRepo {
+// NewAccessRepo creates a new AccessRepo. Recorded visits are published on
+// events.
+func NewAccessRepo(db *pgxpool.Pool, events *pubsub.Broker[*booking.AccessEvent], logger *slog.Logger) *AccessRepo {
 	return &AccessRepo{
-		db: db,
+		db:     db,
+		events: events,
+		logger: logger,
 	}
 }
 
 // CreateAccessPersonal creates a new access record for a personal booking.
 func (r *AccessRepo) CreateAccessPersonal(ctx context.Context, req *booking.CreateAccessPersonalRequest) (*booking.AccessPersonal, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.CreateAccessPersonal")
+	defer span.End()
+
 	// 1. Check booking access status
 	if err := r.checkBookingAccessStatus(ctx, req.AccessPersonal.BookingPersonalId, "booking_personal"); err != nil {
 		return nil, err
 	}
 
-	// 2. Create access record
+	// 2. Check that the visitor shares the booking
+	if req.AccessPersonal.UserId != "" {
+		if err := checkBookingMember(ctx, r.db, subscriptionTypePersonal, req.AccessPersonal.BookingPersonalId, req.AccessPersonal.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Apply the plan's access policy at the visit time
+	reason, err := checkPersonalAccessPolicy(ctx, r.db, req.AccessPersonal.BookingPersonalId, req.AccessPersonal.UserId, req.AccessPersonal.Date)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "access denied: %s", reason)
+	}
+
+	// 4. Create access record, attributed to the account holder by default
 	query := `
 		INSERT INTO access_personal (
 			booking_id,
-			date
-		) VALUES ($1, $2)
-		RETURNING booking_id, date
+			date,
+			user_id
+		) VALUES ($1, $2, COALESCE(NULLIF($3, '')::uuid, (SELECT user_id FROM booking_personal WHERE id = $1)))
+		RETURNING booking_id, date, COALESCE(user_id::text, '')
 	`
 
 	var date time.Time
 
-	err := r.db.QueryRow(ctx, query,
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		req.AccessPersonal.BookingPersonalId,
 		req.AccessPersonal.Date,
+		req.AccessPersonal.UserId,
 	).Scan(
 		&req.AccessPersonal.BookingPersonalId,
 		&date,
+		&req.AccessPersonal.UserId,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
+	event, err := recordBookingVisit(ctx, tx, subscriptionTypePersonal, req.AccessPersonal.BookingPersonalId, req.AccessPersonal.UserId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+	r.events.Publish(event)
+
 	req.AccessPersonal.Date = date.Format(time.RFC3339)
 
 	return req.AccessPersonal, nil
@@ -59,10 +106,14 @@ func (r *AccessRepo) CreateAccessPersonal(ctx context.Context, req *booking.Crea
 
 // ListAccessPersonal retrieves a list of access records for a personal booking.
 func (r *AccessRepo) ListAccessPersonal(ctx context.Context, req *booking.ListAccessPersonalRequest) (*booking.ListAccessPersonalResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.ListAccessPersonal")
+	defer span.End()
+
 	query := `
 		SELECT
 			booking_id,
-			date
+			date,
+			COALESCE(user_id::text, '')
 		FROM access_personal
 		WHERE booking_id = $1
 	`
@@ -84,6 +135,7 @@ func (r *AccessRepo) ListAccessPersonal(ctx context.Context, req *booking.ListAc
 		err := rows.Scan(
 			&access.BookingPersonalId,
 			&date,
+			&access.UserId,
 		)
 
 		if err != nil {
@@ -100,34 +152,72 @@ func (r *AccessRepo) ListAccessPersonal(ctx context.Context, req *booking.ListAc
 
 // CreateAccessGroup creates a new access record for a group booking.
 func (r *AccessRepo) CreateAccessGroup(ctx context.Context, req *booking.CreateAccessGroupRequest) (*booking.AccessGroup, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.CreateAccessGroup")
+	defer span.End()
+
 	// 1. Check booking access status
 	if err := r.checkBookingAccessStatus(ctx, req.AccessGroup.BookingGroupId, "booking_group"); err != nil {
 		return nil, err
 	}
 
-	// 2. Create access record
+	// 2. Check that the visitor shares the booking
+	if req.AccessGroup.UserId != "" {
+		if err := checkBookingMember(ctx, r.db, subscriptionTypeGroup, req.AccessGroup.BookingGroupId, req.AccessGroup.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Check the hall's gender rule
+	reason, err := checkBookingGender(ctx, r.db, subscriptionTypeGroup, req.AccessGroup.BookingGroupId, req.AccessGroup.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "access denied: %s", reason)
+	}
+
+	// 4. Create access record, attributed to the account holder by default
 	query := `
 		INSERT INTO access_group (
 			booking_id,
-			date
-		) VALUES ($1, $2)
-		RETURNING booking_id, date
+			date,
+			user_id
+		) VALUES ($1, $2, COALESCE(NULLIF($3, '')::uuid, (SELECT user_id FROM booking_group WHERE id = $1)))
+		RETURNING booking_id, date, COALESCE(user_id::text, '')
 	`
 
 	var date time.Time
 
-	err := r.db.QueryRow(ctx, query,
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		req.AccessGroup.BookingGroupId,
 		req.AccessGroup.Date,
+		req.AccessGroup.UserId,
 	).Scan(
 		&req.AccessGroup.BookingGroupId,
 		&date,
+		&req.AccessGroup.UserId,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
+	event, err := recordBookingVisit(ctx, tx, subscriptionTypeGroup, req.AccessGroup.BookingGroupId, req.AccessGroup.UserId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+	r.events.Publish(event)
+
 	req.AccessGroup.Date = date.Format(time.RFC3339)
 
 	return req.AccessGroup, nil
@@ -135,10 +225,14 @@ func (r *AccessRepo) CreateAccessGroup(ctx context.Context, req *booking.CreateA
 
 // ListAccessGroup retrieves a list of access records for a group booking.
 func (r *AccessRepo) ListAccessGroup(ctx context.Context, req *booking.ListAccessGroupRequest) (*booking.ListAccessGroupResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.ListAccessGroup")
+	defer span.End()
+
 	query := `
 		SELECT
 			booking_id,
-			date
+			date,
+			COALESCE(user_id::text, '')
 		FROM access_group
 		WHERE booking_id = $1
 	`
@@ -160,6 +254,7 @@ func (r *AccessRepo) ListAccessGroup(ctx context.Context, req *booking.ListAcces
 		err := rows.Scan(
 			&access.BookingGroupId,
 			&date,
+			&access.UserId,
 		)
 
 		if err != nil {
@@ -176,34 +271,72 @@ func (r *AccessRepo) ListAccessGroup(ctx context.Context, req *booking.ListAcces
 
 // CreateAccessCoach creates a new access record for a coach booking.
 func (r *AccessRepo) CreateAccessCoach(ctx context.Context, req *booking.CreateAccessCoachRequest) (*booking.AccessCoach, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.CreateAccessCoach")
+	defer span.End()
+
 	// 1. Check booking access status
 	if err := r.checkBookingAccessStatus(ctx, req.AccessCoach.BookingCoachId, "booking_coach"); err != nil {
 		return nil, err
 	}
 
-	// 2. Create access record
+	// 2. Check that the visitor shares the booking
+	if req.AccessCoach.UserId != "" {
+		if err := checkBookingMember(ctx, r.db, subscriptionTypeCoach, req.AccessCoach.BookingCoachId, req.AccessCoach.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Check the hall's gender rule
+	reason, err := checkBookingGender(ctx, r.db, subscriptionTypeCoach, req.AccessCoach.BookingCoachId, req.AccessCoach.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "access denied: %s", reason)
+	}
+
+	// 4. Create access record, attributed to the account holder by default
 	query := `
 		INSERT INTO access_coach (
 			booking_id,
-			date
-		) VALUES ($1, $2)
-		RETURNING booking_id, date
+			date,
+			user_id
+		) VALUES ($1, $2, COALESCE(NULLIF($3, '')::uuid, (SELECT user_id FROM booking_coach WHERE id = $1)))
+		RETURNING booking_id, date, COALESCE(user_id::text, '')
 	`
 
 	var date time.Time
 
-	err := r.db.QueryRow(ctx, query,
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		req.AccessCoach.BookingCoachId,
 		req.AccessCoach.Date,
+		req.AccessCoach.UserId,
 	).Scan(
 		&req.AccessCoach.BookingCoachId,
 		&date,
+		&req.AccessCoach.UserId,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
+	event, err := recordBookingVisit(ctx, tx, subscriptionTypeCoach, req.AccessCoach.BookingCoachId, req.AccessCoach.UserId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+	r.events.Publish(event)
+
 	req.AccessCoach.Date = date.Format(time.RFC3339)
 
 	return req.AccessCoach, nil
@@ -211,10 +344,14 @@ func (r *AccessRepo) CreateAccessCoach(ctx context.Context, req *booking.CreateA
 
 // ListAccessCoach retrieves a list of access records for a coach booking.
 func (r *AccessRepo) ListAccessCoach(ctx context.Context, req *booking.ListAccessCoachRequest) (*booking.ListAccessCoachResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.ListAccessCoach")
+	defer span.End()
+
 	query := `
 		SELECT
 			booking_id,
-			date
+			date,
+			COALESCE(user_id::text, '')
 		FROM access_coach
 		WHERE booking_id = $1
 	`
@@ -236,6 +373,7 @@ func (r *AccessRepo) ListAccessCoach(ctx context.Context, req *booking.ListAcces
 		err := rows.Scan(
 			&access.BookingCoachId,
 			&date,
+			&access.UserId,
 		)
 
 		if err != nil {
@@ -250,6 +388,309 @@ func (r *AccessRepo) ListAccessCoach(ctx context.Context, req *booking.ListAcces
 	return &booking.ListAccessCoachResponse{AccessCoach: accesses}, nil
 }
 
+// accessEventColumns are the access_events columns read by scanAccessEvents.
+const accessEventColumns = `
+	id,
+	gym_id,
+	COALESCE(user_id::text, ''),
+	COALESCE(booking_id::text, ''),
+	COALESCE(booking_type, ''),
+	direction,
+	counted,
+	created_at,
+	result,
+	COALESCE(reason, ''),
+	COALESCE(device_id, ''),
+	COALESCE(credential_type, '')
+`
+
+// ReplayAccessEvents returns up to limit recorded events matching the filter
+// with an ID greater than afterID, oldest first.
+func (r *AccessRepo) ReplayAccessEvents(ctx context.Context, req *booking.StreamAccessEventsRequest, afterID int64, limit int) ([]*booking.AccessEvent, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.ReplayAccessEvents")
+	defer span.End()
+
+	query := `SELECT ` + accessEventColumns + ` FROM access_events WHERE id > $1`
+
+	args := []interface{}{afterID}
+	count := 2
+
+	if req.GymId != "" {
+		query += fmt.Sprintf(" AND gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+	if req.UserId != "" {
+		query += fmt.Sprintf(" AND user_id = $%d", count)
+		args = append(args, req.UserId)
+		count++
+	}
+	if req.BookingType != "" {
+		query += fmt.Sprintf(" AND booking_type = $%d", count)
+		args = append(args, req.BookingType)
+		count++
+	}
+	if req.Result != "" {
+		query += fmt.Sprintf(" AND result = $%d", count)
+		args = append(args, req.Result)
+		count++
+	}
+
+	query += fmt.Sprintf(" ORDER BY id LIMIT $%d", count)
+	args = append(args, limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error replaying access events: %w", err)
+	}
+
+	return scanAccessEvents(rows)
+}
+
+// ListAccessHistory returns one page of the access log across every booking
+// type, newest first, with totals for the whole filter.
+func (r *AccessRepo) ListAccessHistory(ctx context.Context, req *booking.ListAccessHistoryRequest) (*booking.ListAccessHistoryResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.ListAccessHistory")
+	defer span.End()
+
+	filter := " WHERE 1=1"
+	var args []interface{}
+	count := 1
+
+	if req.UserId != "" {
+		filter += fmt.Sprintf(" AND user_id = $%d", count)
+		args = append(args, req.UserId)
+		count++
+	}
+	if req.GymId != "" {
+		filter += fmt.Sprintf(" AND gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+	if req.BookingType != "" {
+		filter += fmt.Sprintf(" AND booking_type = $%d", count)
+		args = append(args, req.BookingType)
+		count++
+	}
+	if req.From != "" {
+		filter += fmt.Sprintf(" AND created_at >= $%d", count)
+		args = append(args, req.From)
+		count++
+	}
+	if req.To != "" {
+		filter += fmt.Sprintf(" AND created_at < $%d", count)
+		args = append(args, req.To)
+		count++
+	}
+	if req.Result != "" {
+		filter += fmt.Sprintf(" AND result = $%d", count)
+		args = append(args, req.Result)
+		count++
+	}
+
+	var resp booking.ListAccessHistoryResponse
+
+	err := r.db.QueryRow(ctx, `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE result = 'granted'),
+			COUNT(*) FILTER (WHERE result = 'denied'),
+			COUNT(*) FILTER (WHERE result = 'granted' AND direction = 'entry' AND counted)
+		FROM access_events`+filter, args...).Scan(&resp.Total, &resp.Granted, &resp.Denied, &resp.Visits)
+	if err != nil {
+		return nil, fmt.Errorf("error counting access history: %w", err)
+	}
+
+	page, limit := req.Page, req.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	query := `SELECT ` + accessEventColumns + ` FROM access_events` + filter +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", count, count+1)
+	args = append(args, limit, (page-1)*limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing access history: %w", err)
+	}
+
+	resp.Events, err = scanAccessEvents(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListSuspiciousAccess finds members and readers with repeated denied
+// attempts in a time range, such as someone trying another member's ID or
+// tailgating behind others.
+func (r *AccessRepo) ListSuspiciousAccess(ctx context.Context, req *booking.ListSuspiciousAccessRequest) (*booking.ListSuspiciousAccessResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessRepo.ListSuspiciousAccess")
+	defer span.End()
+
+	from := req.From
+	if from == "" {
+		from = time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
+	}
+	minDenials := req.MinDenials
+	if minDenials < 1 {
+		minDenials = 3
+	}
+
+	filter := " WHERE result = 'denied' AND created_at >= $1"
+	args := []interface{}{from, minDenials}
+	count := 3
+
+	if req.To != "" {
+		filter += fmt.Sprintf(" AND created_at < $%d", count)
+		args = append(args, req.To)
+		count++
+	}
+	if req.GymId != "" {
+		filter += fmt.Sprintf(" AND gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+
+	aggregates := `
+		COUNT(*),
+		COALESCE(array_agg(DISTINCT reason) FILTER (WHERE reason IS NOT NULL), '{}'),
+		MIN(created_at),
+		MAX(created_at)
+	`
+	query := `
+		SELECT 'repeated_denials', user_id::text, '', gym_id,` + aggregates + `
+		FROM access_events` + filter + ` AND user_id IS NOT NULL
+		GROUP BY user_id, gym_id
+		HAVING COUNT(*) >= $2
+		UNION ALL
+		SELECT 'device_denials', '', device_id, gym_id,` + aggregates + `
+		FROM access_events` + filter + ` AND device_id IS NOT NULL
+		GROUP BY device_id, gym_id
+		HAVING COUNT(*) >= $2
+		ORDER BY 5 DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing suspicious access: %w", err)
+	}
+	defer rows.Close()
+
+	var patterns []*booking.SuspiciousAccess
+
+	for rows.Next() {
+		var (
+			pattern         booking.SuspiciousAccess
+			firstAt, lastAt time.Time
+		)
+
+		err := rows.Scan(
+			&pattern.Kind,
+			&pattern.UserId,
+			&pattern.DeviceId,
+			&pattern.GymId,
+			&pattern.Denials,
+			&pattern.Reasons,
+			&firstAt,
+			&lastAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning suspicious access: %w", err)
+		}
+
+		pattern.FirstAt = firstAt.Format(time.RFC3339)
+		pattern.LastAt = lastAt.Format(time.RFC3339)
+
+		patterns = append(patterns, &pattern)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error listing suspicious access: %w", err)
+	}
+
+	return &booking.ListSuspiciousAccessResponse{Patterns: patterns}, nil
+}
+
+// scanAccessEvents reads rows selected with accessEventColumns and closes them.
+func scanAccessEvents(rows pgx.Rows) ([]*booking.AccessEvent, error) {
+	defer rows.Close()
+
+	var events []*booking.AccessEvent
+
+	for rows.Next() {
+		var (
+			event     booking.AccessEvent
+			createdAt time.Time
+		)
+
+		err := rows.Scan(
+			&event.Id,
+			&event.GymId,
+			&event.UserId,
+			&event.BookingId,
+			&event.BookingType,
+			&event.Direction,
+			&event.Counted,
+			&createdAt,
+			&event.Result,
+			&event.Reason,
+			&event.DeviceId,
+			&event.CredentialType,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning access event: %w", err)
+		}
+
+		event.CreatedAt = createdAt.Format(time.RFC3339)
+
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+// WatchAccessEvents returns a channel receiving every new event matching the
+// filter until ctx is done. The channel is also closed if the watcher falls
+// too far behind, in which case the caller should replay from its last event.
+func (r *AccessRepo) WatchAccessEvents(ctx context.Context, req *booking.StreamAccessEventsRequest) <-chan *booking.AccessEvent {
+	updates, cancel := r.events.Subscribe()
+	out := make(chan *booking.AccessEvent)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-updates:
+				if !ok {
+					return
+				}
+				if !matchAccessEvent(req, event) {
+					continue
+				}
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
 // checkBookingAccessStatus checks if the booking has "granted" access status.
 func (r *AccessRepo) checkBookingAccessStatus(ctx context.Context, bookingID, bookingTable string) error {
 	var accessStatus string
@@ -258,9 +699,9 @@ func (r *AccessRepo) checkBookingAccessStatus(ctx context.Context, bookingID, bo
 	if err != nil {
 		return fmt.Errorf("error checking booking access status: %w", err)
 	}
-	log.Print(bookingID, accessStatus)
+	r.logger.DebugContext(ctx, "checked booking access status", "booking_id", bookingID, "access_status", accessStatus)
 	if accessStatus != "granted" {
-		return fmt.Errorf("access denied: booking status is not 'granted'")
+		return storage.Errorf(storage.ErrFailedPrecondition, "access denied: booking status is not 'granted'")
 	}
 	return nil
 }
@@ -3,62 +3,335 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"github.com/Athlevo/Booking-Athlevo/checkin"
+	"github.com/Athlevo/Booking-Athlevo/config"
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // AccessRepo implements the AccessRepoI interface for Access entities.
 type AccessBetaRepo struct {
-	db *pgx.Conn
+	db                  *pgxpool.Pool
+	reentryTimeout      time.Duration
+	duplicateScanWindow time.Duration
+	occupancy           *pubsub.Broker[*booking.Occupancy]
+	events              *pubsub.Broker[*booking.AccessEvent]
+	tokens              *checkin.Signer // nil when check-in tokens are not configured
+	faces               storage.FaceResolver
+	faceMinConfidence   float64
+	pruner              *accessEventPruner
+	logger              *slog.Logger
 }
 
-// NewAccessRepo creates a new AccessRepo.
-func NewAccessBetaRepo(db *pgx.Conn) *AccessBetaRepo {
+// NewAccessRepo creates a new AccessRepo. Entries and exits publish the new
+// hall occupancy on occupancy and the scan itself on events.
+func NewAccessBetaRepo(db *pgxpool.Pool, cfg config.Config, occupancy *pubsub.Broker[*booking.Occupancy], events *pubsub.Broker[*booking.AccessEvent], logger *slog.Logger) *AccessBetaRepo {
+	var tokens *checkin.Signer
+	if cfg.CheckInTokenSecret != "" {
+		tokens = checkin.NewSigner([]byte(cfg.CheckInTokenSecret), cfg.CheckInTokenPeriod)
+	}
+
 	return &AccessBetaRepo{
-		db: db,
+		db:                  db,
+		reentryTimeout:      cfg.AccessReentryTimeout,
+		duplicateScanWindow: cfg.AccessDuplicateScanWindow,
+		occupancy:           occupancy,
+		events:              events,
+		tokens:              tokens,
+		faces:               NewFaceResolver(db),
+		faceMinConfidence:   cfg.FaceMinConfidence,
+		pruner:              newAccessEventPruner(cfg.AccessAuditRetention, logger),
+		logger:              logger,
 	}
 }
 
 // CheckUserAccess checks if the user has access to the sport hall for personal subscriptions.
 func (r *AccessBetaRepo) CheckUserAccess(ctx context.Context, req *booking.AccessBetaPersonalRequest) (*booking.AccessBetaPersonalResponse, error) {
-	// 1. Find the user's active personal booking for the sport hall
+	ctx, span := tracing.Start(ctx, "AccessBetaRepo.CheckUserAccess")
+	defer span.End()
+
+	return r.checkAccess(ctx, req, credentialUserID)
+}
+
+// checkAccess runs the access check for a member identified by credential
+// and logs the attempt whatever the decision.
+func (r *AccessBetaRepo) checkAccess(ctx context.Context, req *booking.AccessBetaPersonalRequest, credential string) (*booking.AccessBetaPersonalResponse, error) {
+	resp, err := r.checkUserAccess(ctx, req, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Message == "denied" {
+		err = r.recordDenial(ctx, accessEvent{
+			gymID:          req.SportHallId,
+			userID:         req.UserId,
+			deviceID:       req.DeviceId,
+			credentialType: credential,
+			reason:         resp.Reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		metrics.AccessDecisions.WithLabelValues(accessResultGranted, "").Inc()
+	}
+
+	return resp, nil
+}
+
+// recordDenial logs a denied attempt, an entry unless event says otherwise.
+func (r *AccessBetaRepo) recordDenial(ctx context.Context, event accessEvent) error {
+	if event.direction == "" {
+		event.direction = accessDirectionEntry
+	}
+	event.result = accessResultDenied
+	if err := recordAccessEvent(ctx, r.db, r.events, event); err != nil {
+		return err
+	}
+	metrics.AccessDecisions.WithLabelValues(accessResultDenied, event.reason).Inc()
+	r.pruner.prune(ctx, r.db)
+	return nil
+}
+
+// checkUserAccess decides on an entry and records it when granted.
+func (r *AccessBetaRepo) checkUserAccess(ctx context.Context, req *booking.AccessBetaPersonalRequest, credential string) (*booking.AccessBetaPersonalResponse, error) {
+	// 1. Block passback: a user who entered and has not left cannot enter
+	//    again until the re-entry timeout. A repeated scan right after an
+	//    entry opens the gate again without counting another visit.
+	last, err := lastAccessEvent(ctx, r.db, req.UserId, req.SportHallId)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil && last.direction == accessDirectionEntry {
+		switch {
+		case last.age < r.duplicateScanWindow:
+			last.counted = false
+			last.deviceID = req.DeviceId
+			last.credentialType = credential
+			if err := recordAccessEvent(ctx, r.db, r.events, *last); err != nil {
+				return nil, err
+			}
+			return &booking.AccessBetaPersonalResponse{Message: "granted"}, nil
+		case last.age < r.reentryTimeout:
+			return &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonAlreadyInside}, nil
+		}
+	}
+
+	// 2. Keep the hall within its maximum occupancy
+	reason, err := checkOccupancy(ctx, r.db, req.SportHallId, r.reentryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return &booking.AccessBetaPersonalResponse{Message: "denied", Reason: reason}, nil
+	}
+
+	// 3. Find the user's active personal bookings for the sport hall
+	//    Shared bookings count when the user is a member with visits left on their own limit.
 	query := `
-		SELECT bp.id, bp.access_status
+		SELECT bp.id, COALESCE(bp.bundle_purchase_id::text, '')
 		FROM booking_personal bp
 		JOIN subscription_personal sp ON bp.subscription_id = sp.id
-		WHERE bp.user_id = $1 AND sp.gym_id = $2 AND bp.access_status = 'granted'
+		LEFT JOIN booking_members bm ON bm.booking_type = 'personal' AND bm.booking_id = bp.id AND bm.user_id = $1
+		WHERE (bp.user_id = $1 OR bm.user_id IS NOT NULL) AND sp.gym_id = $2 AND bp.access_status = 'granted'
 		AND bp.start_date <= NOW()
+		AND (
+			bm.visit_limit IS NULL OR bm.visit_limit = 0 OR
+			(SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = bp.id AND ap.user_id = $1) < bm.visit_limit
+		)
+		ORDER BY bp.user_id = $1 DESC
 	`
 
-	var bookingID string
-	var accessStatus string
-	err := r.db.QueryRow(ctx, query, req.UserId, req.SportHallId).Scan(&bookingID, &accessStatus)
+	rows, err := r.db.Query(ctx, query, req.UserId, req.SportHallId)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return &booking.AccessBetaPersonalResponse{Message: "denied"}, nil
+		return nil, fmt.Errorf("error checking user access: %w", err)
+	}
+
+	type candidate struct {
+		bookingID        string
+		bundlePurchaseID string
+	}
+	var candidates []candidate
+	for rows.Next() {
+		var c candidate
+		if err := rows.Scan(&c.bookingID, &c.bundlePurchaseID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("error checking user access: %w", err)
 		}
+		candidates = append(candidates, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error checking user access: %w", err)
 	}
 
-	// 2. If access is granted, create an access_personal record
-	if accessStatus == "granted" {
-		if err := r.createAccessPersonalRecord(ctx, bookingID); err != nil {
-			return nil, fmt.Errorf("error creating access personal record: %w", err)
+	// 4. Use the first booking the access policy allows right now and create
+	//    an access_personal record for it
+	for _, c := range candidates {
+		denyReason, err := checkPersonalAccessPolicy(ctx, r.db, c.bookingID, req.UserId, "")
+		if err != nil {
+			return nil, err
+		}
+		if denyReason != "" {
+			if reason == "" {
+				reason = denyReason
+			}
+			continue
+		}
+
+		tx, err := r.db.Begin(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error starting transaction: %w", err)
+		}
+		defer tx.Rollback(ctx)
+
+		if err := createAccessPersonalRecord(ctx, tx, c.bookingID, req.UserId); err != nil {
+			return nil, err
+		}
+		err = r.commitEntry(ctx, tx, accessEvent{
+			gymID:          req.SportHallId,
+			userID:         req.UserId,
+			bookingID:      c.bookingID,
+			bookingType:    subscriptionTypePersonal,
+			direction:      accessDirectionEntry,
+			counted:        true,
+			deviceID:       req.DeviceId,
+			credentialType: credential,
+		})
+		if err != nil {
+			return nil, err
 		}
-		return &booking.AccessBetaPersonalResponse{Message: "granted"}, nil
+		r.publishOccupancy(ctx, req.SportHallId)
+		return &booking.AccessBetaPersonalResponse{Message: "granted", BundlePurchaseId: c.bundlePurchaseID}, nil
 	}
 
-	return &booking.AccessBetaPersonalResponse{Message: "denied"}, nil
+	// 5. No booking allows entry right now, so try a trial or guest pass. If
+	//    there is none either, the bookings' denial explains more than
+	//    having no booking.
+	resp, err := r.checkPassAccess(ctx, req, credential)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Message == "denied" && reason != "" {
+		resp.Reason = reason
+	}
+	return resp, nil
+}
+
+// checkPassAccess grants access through a trial or guest pass when no booking
+// of the user grants access to the sport hall.
+func (r *AccessBetaRepo) checkPassAccess(ctx context.Context, req *booking.AccessBetaPersonalRequest, credential string) (*booking.AccessBetaPersonalResponse, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	passID, err := redeemPass(ctx, tx, req.UserId, req.SportHallId)
+	if err != nil {
+		return nil, err
+	}
+	if passID == "" {
+		return &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonNoBooking}, nil
+	}
+
+	err = r.commitEntry(ctx, tx, accessEvent{
+		gymID:          req.SportHallId,
+		userID:         req.UserId,
+		bookingID:      passID,
+		bookingType:    "pass",
+		direction:      accessDirectionEntry,
+		counted:        true,
+		deviceID:       req.DeviceId,
+		credentialType: credential,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.publishOccupancy(ctx, req.SportHallId)
+
+	return &booking.AccessBetaPersonalResponse{Message: "granted"}, nil
+}
+
+// CheckUserExit logs the user leaving the sport hall, which allows them to
+// enter again. An exit is matched to the user's granted entry within the
+// re-entry timeout; without one it is denied and logged as a denied attempt,
+// so occupancy and history only hold exits that pair with an entry.
+func (r *AccessBetaRepo) CheckUserExit(ctx context.Context, req *booking.AccessBetaPersonalRequest) (*booking.AccessBetaPersonalResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessBetaRepo.CheckUserExit")
+	defer span.End()
+
+	event := accessEvent{
+		gymID:          req.SportHallId,
+		userID:         req.UserId,
+		direction:      accessDirectionExit,
+		deviceID:       req.DeviceId,
+		credentialType: credentialUserID,
+	}
+
+	last, err := lastAccessEvent(ctx, r.db, req.UserId, req.SportHallId)
+	if err != nil {
+		return nil, err
+	}
+	if last == nil || last.direction != accessDirectionEntry || last.age >= r.reentryTimeout {
+		event.reason = denyReasonNoEntry
+		if err := r.recordDenial(ctx, event); err != nil {
+			return nil, err
+		}
+		return &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonNoEntry}, nil
+	}
+	event.bookingID = last.bookingID
+	event.bookingType = last.bookingType
+
+	if err := recordAccessEvent(ctx, r.db, r.events, event); err != nil {
+		return nil, err
+	}
+	r.publishOccupancy(ctx, req.SportHallId)
+
+	return &booking.AccessBetaPersonalResponse{Message: "granted"}, nil
+}
+
+// publishOccupancy publishes the hall's occupancy after an entry or exit.
+// The scan has already been recorded, so a failure here is only logged.
+func (r *AccessBetaRepo) publishOccupancy(ctx context.Context, gymID string) {
+	occupancy, err := getOccupancy(ctx, r.db, gymID, r.reentryTimeout)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "error publishing occupancy", "gym_id", gymID, "error", err)
+		return
+	}
+	r.occupancy.Publish(occupancy)
+}
+
+// commitEntry logs a counted entry on tx, which already holds the visit it
+// counts, so the visit, the scan and its outbox rows commit together. The
+// scan is published once committed.
+func (r *AccessBetaRepo) commitEntry(ctx context.Context, tx pgx.Tx, event accessEvent) error {
+	published, err := insertAccessEvent(ctx, tx, event)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+	r.events.Publish(published)
+	return nil
 }
 
 // createAccessPersonalRecord creates a new access_personal record.
-func (r *AccessBetaRepo) createAccessPersonalRecord(ctx context.Context, bookingID string) error {
+func createAccessPersonalRecord(ctx context.Context, q querier, bookingID, userID string) error {
 	query := `
-		INSERT INTO access_personal (booking_id, date)
-		VALUES ($1, NOW())
+		INSERT INTO access_personal (booking_id, date, user_id)
+		VALUES ($1, NOW(), $2)
 	`
-	_, err := r.db.Exec(ctx, query, bookingID)
+	_, err := q.Exec(ctx, query, bookingID, userID)
 	if err != nil {
 		return fmt.Errorf("error creating access personal record: %w", err)
 	}
@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/jackc/pgx/v5"
+)
+
+// Turnstile directions.
+const (
+	accessDirectionEntry = "entry"
+	accessDirectionExit  = "exit"
+)
+
+// Credentials a member can identify with.
+const (
+	credentialUserID  = "user_id"
+	credentialToken   = "token"
+	credentialFace    = "face"
+	credentialOffline = "offline"
+	credentialStaff   = "staff"
+)
+
+// accessEventPruneInterval is how often old access events are deleted.
+const accessEventPruneInterval = time.Hour
+
+// Access decisions.
+const (
+	accessResultGranted = "granted"
+	accessResultDenied  = "denied"
+)
+
+// accessEvent is one turnstile scan logged in access_events.
+type accessEvent struct {
+	gymID       string
+	userID      string
+	bookingID   string
+	bookingType string
+	direction   string
+	counted     bool
+	age         time.Duration // time since the event, measured on the database clock
+	at          time.Time     // when the event happened; zero means now
+	result      string        // empty means granted
+	reason      string        // why a denied attempt was denied
+
+	deviceID       string
+	credentialType string
+}
+
+// lastAccessEvent returns the user's most recent scan at the gym, or nil if
+// there is none.
+func lastAccessEvent(ctx context.Context, q querier, userID, gymID string) (*accessEvent, error) {
+	event := accessEvent{
+		gymID:  gymID,
+		userID: userID,
+	}
+
+	var ageSeconds float64
+	err := q.QueryRow(ctx, `
+		SELECT
+			COALESCE(booking_id::text, ''),
+			COALESCE(booking_type, ''),
+			direction,
+			counted,
+			EXTRACT(EPOCH FROM NOW() - created_at)::float8
+		FROM access_events
+		WHERE user_id = $1 AND gym_id = $2 AND result = 'granted'
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1
+	`, userID, gymID).Scan(
+		&event.bookingID,
+		&event.bookingType,
+		&event.direction,
+		&event.counted,
+		&ageSeconds,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting last access event: %w", err)
+	}
+
+	event.age = time.Duration(ageSeconds * float64(time.Second))
+
+	return &event, nil
+}
+
+// recordAccessEvent logs a turnstile scan and publishes it on events.
+func recordAccessEvent(ctx context.Context, q querier, events *pubsub.Broker[*booking.AccessEvent], event accessEvent) error {
+	var published *booking.AccessEvent
+	err := pgx.BeginFunc(ctx, q, func(tx pgx.Tx) error {
+		var err error
+		published, err = insertAccessEvent(ctx, tx, event)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(published)
+	return nil
+}
+
+// insertAccessEvent logs a turnstile scan without publishing it, for callers
+// that publish once their transaction has committed. Counted entries also
+// write their domain events to the outbox, so q should be a transaction.
+func insertAccessEvent(ctx context.Context, q querier, event accessEvent) (*booking.AccessEvent, error) {
+	var at any
+	if !event.at.IsZero() {
+		at = event.at
+	}
+	if event.result == "" {
+		event.result = accessResultGranted
+	}
+
+	var (
+		published booking.AccessEvent
+		createdAt time.Time
+	)
+	err := q.QueryRow(ctx, `
+		INSERT INTO access_events (
+			gym_id,
+			user_id,
+			booking_id,
+			booking_type,
+			direction,
+			counted,
+			created_at,
+			result,
+			reason,
+			device_id,
+			credential_type
+		) VALUES (
+			$1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, NULLIF($4, ''), $5, $6,
+			COALESCE($7::timestamp, NOW()), $8, NULLIF($9, ''), NULLIF($10, ''), NULLIF($11, '')
+		)
+		RETURNING id, created_at
+	`,
+		event.gymID,
+		event.userID,
+		event.bookingID,
+		event.bookingType,
+		event.direction,
+		event.counted,
+		at,
+		event.result,
+		event.reason,
+		event.deviceID,
+		event.credentialType,
+	).Scan(&published.Id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("error recording access event: %w", err)
+	}
+
+	published.GymId = event.gymID
+	published.UserId = event.userID
+	published.BookingId = event.bookingID
+	published.BookingType = event.bookingType
+	published.Direction = event.direction
+	published.Counted = event.counted
+	published.Result = event.result
+	published.Reason = event.reason
+	published.DeviceId = event.deviceID
+	published.CredentialType = event.credentialType
+	published.CreatedAt = createdAt.Format(time.RFC3339)
+
+	if published.Result == accessResultGranted && published.Counted && published.Direction == accessDirectionEntry {
+		if err := enqueueVisitEvents(ctx, q, &published); err != nil {
+			return nil, err
+		}
+	}
+
+	return &published, nil
+}
+
+// accessEventPruner deletes denied access attempts older than the retention
+// period, at most once per accessEventPruneInterval. Granted events are the
+// members' attendance history and are kept.
+type accessEventPruner struct {
+	retention time.Duration // zero keeps denied attempts forever
+	logger    *slog.Logger
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newAccessEventPruner(retention time.Duration, logger *slog.Logger) *accessEventPruner {
+	return &accessEventPruner{retention: retention, logger: logger}
+}
+
+// prune deletes expired denied attempts if the interval has passed. The attempt has
+// already been recorded, so a failure here is only logged.
+func (p *accessEventPruner) prune(ctx context.Context, q querier) {
+	if p.retention <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	now := time.Now()
+	if now.Before(p.next) {
+		p.mu.Unlock()
+		return
+	}
+	p.next = now.Add(accessEventPruneInterval)
+	p.mu.Unlock()
+
+	_, err := q.Exec(ctx, `
+		DELETE FROM access_events
+		WHERE result = 'denied' AND created_at < NOW() - make_interval(secs => $1)
+	`, p.retention.Seconds())
+	if err != nil {
+		p.logger.ErrorContext(ctx, "error pruning access events", "error", err)
+	}
+}
+
+// recordBookingVisit logs a visit recorded by staff as an entry at the hall
+// the booking belongs to. q should be the transaction that wrote the visit;
+// the caller publishes the returned event once it commits.
+func recordBookingVisit(ctx context.Context, q querier, bookingType, bookingID, userID string, date time.Time) (*booking.AccessEvent, error) {
+	tables, err := lookupBookingTable(bookingType)
+	if err != nil {
+		return nil, err
+	}
+
+	var gymID string
+	query := fmt.Sprintf(`
+		SELECT s.gym_id
+		FROM %s b
+		JOIN subscription_%s s ON s.id = b.subscription_id
+		WHERE b.id = $1
+	`, tables.booking, bookingType)
+	if err := q.QueryRow(ctx, query, bookingID).Scan(&gymID); err != nil {
+		return nil, fmt.Errorf("error getting booking hall: %w", err)
+	}
+
+	return insertAccessEvent(ctx, q, accessEvent{
+		gymID:          gymID,
+		userID:         userID,
+		bookingID:      bookingID,
+		bookingType:    bookingType,
+		direction:      accessDirectionEntry,
+		counted:        true,
+		at:             date,
+		credentialType: credentialStaff,
+	})
+}
+
+// matchAccessEvent reports whether an event passes the stream filter.
+func matchAccessEvent(req *booking.StreamAccessEventsRequest, event *booking.AccessEvent) bool {
+	return (req.GymId == "" || event.GymId == req.GymId) &&
+		(req.UserId == "" || event.UserId == req.UserId) &&
+		(req.BookingType == "" || event.BookingType == req.BookingType) &&
+		(req.Result == "" || event.Result == req.Result)
+}
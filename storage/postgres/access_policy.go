@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/storage"
+)
+
+// Denial reasons reported by access checks.
+const (
+	denyReasonNoBooking     = "no active booking"
+	denyReasonOutsideHours  = "outside allowed hours"
+	denyReasonGender        = "hall is restricted to another gender"
+	denyReasonAlreadyInside = "already checked in, exit not recorded"
+	denyReasonHallFull      = "hall is at maximum occupancy"
+	denyReasonInvalidToken  = "invalid or expired check-in token"
+	denyReasonTokenUsed     = "check-in token already used"
+	denyReasonLowConfidence = "face match confidence too low"
+	denyReasonUnknownFace   = "face not recognized"
+	denyReasonNoEntry       = "no entry recorded"
+)
+
+// checkPersonalAccessPolicy applies the plan rules that depend on who uses a
+// personal booking, and when, on top of its access status. It returns an
+// empty reason when the visit is allowed. userID is the visitor; an empty
+// string means the account holder. at is the visit time; an empty string
+// means now.
+func checkPersonalAccessPolicy(ctx context.Context, q querier, bookingID, userID, at string) (string, error) {
+	// 1. Gender-restricted halls only admit members of that gender
+	reason, err := checkBookingGender(ctx, q, subscriptionTypePersonal, bookingID, userID)
+	if err != nil || reason != "" {
+		return reason, err
+	}
+
+	// 2. Plans with time windows only grant access inside one of them,
+	//    evaluated on the gym's local clock. The windows are those of the
+	//    plan version the booking was sold under
+	var withinHours bool
+	err = q.QueryRow(ctx, `
+		WITH visit AS (
+			SELECT
+				bp.subscription_id,
+				bp.subscription_version,
+				COALESCE(NULLIF($2, '')::timestamptz, NOW()) AT TIME ZONE sh.timezone AS local_time
+			FROM booking_personal bp
+			JOIN subscription_personal sp ON sp.id = bp.subscription_id
+			JOIN sport_halls sh ON sh.id = sp.gym_id
+			WHERE bp.id = $1
+		)
+		SELECT
+			NOT EXISTS (
+				SELECT 1
+				FROM subscription_time_windows w
+				WHERE w.subscription_id = v.subscription_id AND w.version = v.subscription_version
+			) OR
+			EXISTS (
+				SELECT 1
+				FROM subscription_time_windows w
+				WHERE w.subscription_id = v.subscription_id
+					AND w.version = v.subscription_version
+					AND w.weekday = EXTRACT(DOW FROM v.local_time)
+					AND v.local_time::time >= w.start_time
+					AND v.local_time::time < w.end_time
+			)
+		FROM visit v
+	`, bookingID, at).Scan(&withinHours)
+	if err != nil {
+		return "", fmt.Errorf("error checking plan time windows: %w", err)
+	}
+	if !withinHours {
+		return denyReasonOutsideHours, nil
+	}
+
+	return "", nil
+}
+
+// checkBookingGender applies the gender rule of the hall a booking belongs
+// to. userID is the visitor; an empty string means the account holder.
+func checkBookingGender(ctx context.Context, q querier, bookingType, bookingID, userID string) (string, error) {
+	tables, err := lookupBookingTable(bookingType)
+	if err != nil {
+		return "", err
+	}
+
+	var gymID string
+	query := fmt.Sprintf(`
+		SELECT s.gym_id, COALESCE(NULLIF($2, ''), b.user_id::text)
+		FROM %s b
+		JOIN subscription_%s s ON s.id = b.subscription_id
+		WHERE b.id = $1
+	`, tables.booking, bookingType)
+	if err := q.QueryRow(ctx, query, bookingID, userID).Scan(&gymID, &userID); err != nil {
+		return "", fmt.Errorf("error getting booking hall: %w", err)
+	}
+
+	return checkGenderPolicy(ctx, q, gymID, userID)
+}
+
+// checkSubscriptionGender applies the gender rule of the hall a plan belongs
+// to, for use before a booking is created for userID.
+func checkSubscriptionGender(ctx context.Context, q querier, subscriptionType, subscriptionID, userID string) error {
+	if _, err := lookupBookingTable(subscriptionType); err != nil {
+		return err
+	}
+
+	var gymID string
+	query := fmt.Sprintf(`SELECT gym_id FROM subscription_%s WHERE id = $1`, subscriptionType)
+	if err := q.QueryRow(ctx, query, subscriptionID).Scan(&gymID); err != nil {
+		return fmt.Errorf("error getting plan hall: %w", err)
+	}
+
+	reason, err := checkGenderPolicy(ctx, q, gymID, userID)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return storage.Errorf(storage.ErrFailedPrecondition, "access denied: %s", reason)
+	}
+	return nil
+}
+
+// checkGenderPolicy compares the hall's gender with the member's profile.
+// Members with an active override granted by staff are let through. So are
+// members without a recorded gender: every hall has a gender, so denying
+// them would lock out each member whose profile staff have not yet filled
+// in through SetMemberGender.
+func checkGenderPolicy(ctx context.Context, q querier, gymID, userID string) (string, error) {
+	var allowed bool
+	err := q.QueryRow(ctx, `
+		SELECT
+			u.gender IS NULL OR u.gender = sh.type_gender OR EXISTS (
+				SELECT 1 FROM gender_overrides o
+				WHERE o.gym_id = sh.id AND o.user_id = $2::uuid AND o.revoked_at IS NULL
+			)
+		FROM sport_halls sh
+		LEFT JOIN users u ON u.id = $2::uuid
+		WHERE sh.id = $1
+	`, gymID, userID).Scan(&allowed)
+	if err != nil {
+		return "", fmt.Errorf("error checking hall gender: %w", err)
+	}
+	if !allowed {
+		return denyReasonGender, nil
+	}
+	return "", nil
+}
+
+// checkOccupancy denies entry while the hall holds its maximum number of
+// members.
+func checkOccupancy(ctx context.Context, q querier, gymID string, reentryTimeout time.Duration) (string, error) {
+	occupancy, err := getOccupancy(ctx, q, gymID, reentryTimeout)
+	if err != nil {
+		return "", err
+	}
+	if occupancy.MaxOccupancy > 0 && occupancy.Current >= occupancy.MaxOccupancy {
+		return denyReasonHallFull, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// beginAudited starts a transaction whose changes the audit triggers
+// attribute to the request in ctx. Changes made outside such a transaction
+// are still logged, without an actor.
+func beginAudited(ctx context.Context, db *pgxpool.Pool) (pgx.Tx, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	md := audit.FromContext(ctx)
+	_, err = tx.Exec(ctx, `
+		SELECT
+			set_config('athlevo.actor', $1, true),
+			set_config('athlevo.rpc', $2, true),
+			set_config('athlevo.request_id', $3, true)
+	`, md.Actor, md.RPC, md.RequestID)
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, fmt.Errorf("error setting audit context: %w", err)
+	}
+
+	return tx, nil
+}
+
+// AuditRepo implements the AuditRepoI interface for the audit log.
+type AuditRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewAuditRepo creates a new AuditRepo.
+func NewAuditRepo(db *pgxpool.Pool, logger *slog.Logger) *AuditRepo {
+	return &AuditRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetEntityHistory returns every recorded change to an entity, oldest first.
+func (r *AuditRepo) GetEntityHistory(ctx context.Context, req *booking.GetEntityHistoryRequest) (*booking.GetEntityHistoryResponse, error) {
+	ctx, span := tracing.Start(ctx, "AuditRepo.GetEntityHistory")
+	defer span.End()
+
+	if req.EntityType == "" || req.EntityId == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "entity_type and entity_id are required")
+	}
+
+	query := `
+		SELECT
+			id,
+			entity_type,
+			entity_id,
+			action,
+			COALESCE(actor, ''),
+			COALESCE(rpc, ''),
+			COALESCE(request_id, ''),
+			COALESCE(before::text, ''),
+			COALESCE(after::text, ''),
+			created_at
+		FROM audit_log
+		WHERE entity_type = $1 AND entity_id = $2
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(ctx, query, req.EntityType, req.EntityId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*booking.AuditEntry
+
+	for rows.Next() {
+		var (
+			entry     booking.AuditEntry
+			createdAt time.Time
+		)
+		err := rows.Scan(
+			&entry.Id,
+			&entry.EntityType,
+			&entry.EntityId,
+			&entry.Action,
+			&entry.Actor,
+			&entry.Rpc,
+			&entry.RequestId,
+			&entry.Before,
+			&entry.After,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		entry.CreatedAt = createdAt.Format(time.RFC3339)
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return &booking.GetEntityHistoryResponse{Entries: entries}, nil
+}
@@ -3,27 +3,47 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/Athlevo/Booking-Athlevo/events"
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // BookingCoachRepo implements the BookingRepoI interface for BookingCoach entities.
 type BookingCoachRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
 // NewBookingCoachRepo creates a new BookingCoachRepo.
-func NewBookingCoachRepo(db *pgx.Conn) *BookingCoachRepo {
+func NewBookingCoachRepo(db *pgxpool.Pool, logger *slog.Logger) *BookingCoachRepo {
 	return &BookingCoachRepo{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 
 // CreateBookingCoach creates a new booking coach record.
 func (r *BookingCoachRepo) CreateBookingCoach(ctx context.Context, req *booking.CreateBookingCoachRequest) (*booking.BookingCoach, error) {
+	ctx, span := tracing.Start(ctx, "BookingCoachRepo.CreateBookingCoach")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkSubscriptionGender(ctx, tx, subscriptionTypeCoach, req.BookingCoach.SubscriptionId, req.BookingCoach.UserId); err != nil {
+		return nil, err
+	}
+
 	req.BookingCoach.Id = uuid.New().String()
 	query := `
 		INSERT INTO booking_coach (
@@ -34,10 +54,11 @@ func (r *BookingCoachRepo) CreateBookingCoach(ctx context.Context, req *booking.
 			access_status,
 			start_date,
 			count,
+			subscription_version,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT version FROM subscription_coach WHERE id = $3), NOW(), NOW())
+		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, subscription_version, created_at, updated_at
 	`
 
 	var (
@@ -46,7 +67,7 @@ func (r *BookingCoachRepo) CreateBookingCoach(ctx context.Context, req *booking.
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.BookingCoach.Id,
 		req.BookingCoach.UserId,
 		req.BookingCoach.SubscriptionId,
@@ -62,6 +83,7 @@ func (r *BookingCoachRepo) CreateBookingCoach(ctx context.Context, req *booking.
 		&req.BookingCoach.AccessStatus,
 		&startDate,
 		&req.BookingCoach.Count,
+		&req.BookingCoach.SubscriptionVersion,
 		&createdAt,
 		&updatedAt,
 	)
@@ -74,11 +96,23 @@ func (r *BookingCoachRepo) CreateBookingCoach(ctx context.Context, req *booking.
 	req.BookingCoach.CreatedAt = createdAt.Format(time.RFC3339)
 	req.BookingCoach.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCreated, subscriptionTypeCoach, req.BookingCoach.Id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	metrics.BookingsCreated.WithLabelValues(subscriptionTypeCoach).Inc()
+
 	return req.BookingCoach, nil
 }
 
 // GetBookingCoach retrieves a booking coach record by ID.
 func (r *BookingCoachRepo) GetBookingCoach(ctx context.Context, req *booking.GetBookingCoachRequest) (*booking.BookingCoach, error) {
+	ctx, span := tracing.Start(ctx, "BookingCoachRepo.GetBookingCoach")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -88,6 +122,8 @@ func (r *BookingCoachRepo) GetBookingCoach(ctx context.Context, req *booking.Get
 			access_status,
 			start_date,
 			count,
+			subscription_version,
+			COALESCE(bundle_purchase_id::text, ''),
 			created_at,
 			updated_at
 		FROM booking_coach
@@ -109,6 +145,8 @@ func (r *BookingCoachRepo) GetBookingCoach(ctx context.Context, req *booking.Get
 		&booking.AccessStatus,
 		&startDate,
 		&booking.Count,
+		&booking.SubscriptionVersion,
+		&booking.BundlePurchaseId,
 		&createdAt,
 		&updatedAt,
 	)
@@ -129,6 +167,15 @@ func (r *BookingCoachRepo) GetBookingCoach(ctx context.Context, req *booking.Get
 
 // UpdateBookingCoach updates an existing booking coach record.
 func (r *BookingCoachRepo) UpdateBookingCoach(ctx context.Context, req *booking.UpdateBookingCoachRequest) (*booking.BookingCoach, error) {
+	ctx, span := tracing.Start(ctx, "BookingCoachRepo.UpdateBookingCoach")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		UPDATE booking_coach
 		SET
@@ -138,9 +185,13 @@ func (r *BookingCoachRepo) UpdateBookingCoach(ctx context.Context, req *booking.
 			access_status = $4,
 			start_date = $5,
 			count = $6,
+			subscription_version = CASE
+				WHEN subscription_id = $2 THEN subscription_version
+				ELSE (SELECT version FROM subscription_coach WHERE id = $2)
+			END,
 			updated_at = NOW()
 		WHERE id = $7
-		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, created_at, updated_at
+		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, subscription_version, created_at, updated_at
 	`
 
 	var (
@@ -149,7 +200,7 @@ func (r *BookingCoachRepo) UpdateBookingCoach(ctx context.Context, req *booking.
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.BookingCoach.UserId,
 		req.BookingCoach.SubscriptionId,
 		req.BookingCoach.Payment,
@@ -165,6 +216,7 @@ func (r *BookingCoachRepo) UpdateBookingCoach(ctx context.Context, req *booking.
 		&req.BookingCoach.AccessStatus,
 		&startDate,
 		&req.BookingCoach.Count,
+		&req.BookingCoach.SubscriptionVersion,
 		&createdAt,
 		&updatedAt,
 	)
@@ -180,17 +232,34 @@ func (r *BookingCoachRepo) UpdateBookingCoach(ctx context.Context, req *booking.
 	req.BookingCoach.CreatedAt = createdAt.Format(time.RFC3339)
 	req.BookingCoach.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return req.BookingCoach, nil
 }
 
 // DeleteBookingCoach deletes a booking coach record by ID.
 func (r *BookingCoachRepo) DeleteBookingCoach(ctx context.Context, req *booking.DeleteBookingCoachRequest) error {
+	ctx, span := tracing.Start(ctx, "BookingCoachRepo.DeleteBookingCoach")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCancelled, subscriptionTypeCoach, req.Id); err != nil {
+		return err
+	}
+
 	query := `
 		DELETE FROM booking_coach
 		WHERE id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, req.Id)
+	result, err := tx.Exec(ctx, query, req.Id)
 	if err != nil {
 		return err
 	}
@@ -199,11 +268,18 @@ func (r *BookingCoachRepo) DeleteBookingCoach(ctx context.Context, req *booking.
 		return pgx.ErrNoRows
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ListBookingCoach retrieves a list of booking coach records with optional filtering.
 func (r *BookingCoachRepo) ListBookingCoach(ctx context.Context, req *booking.ListBookingCoachRequest) (*booking.ListBookingCoachResponse, error) {
+	ctx, span := tracing.Start(ctx, "BookingCoachRepo.ListBookingCoach")
+	defer span.End()
+
 	var args []interface{}
 	count := 1
 	query := `
@@ -215,6 +291,8 @@ func (r *BookingCoachRepo) ListBookingCoach(ctx context.Context, req *booking.Li
 			access_status,
 			start_date,
 			count,
+			subscription_version,
+			COALESCE(bundle_purchase_id::text, ''),
 			created_at,
 			updated_at
 		FROM booking_coach
@@ -257,6 +335,8 @@ func (r *BookingCoachRepo) ListBookingCoach(ctx context.Context, req *booking.Li
 			&booking.AccessStatus,
 			&startDate,
 			&booking.Count,
+			&booking.SubscriptionVersion,
+			&booking.BundlePurchaseId,
 			&createdAt,
 			&updatedAt,
 		)
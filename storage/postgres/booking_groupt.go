@@ -3,53 +3,54 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/Athlevo/Booking-Athlevo/events"
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // BookingGroupRepo implements the BookingRepoI interface for BookingGroup entities.
 type BookingGroupRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
 // NewBookingGroupRepo creates a new BookingGroupRepo.
-func NewBookingGroupRepo(db *pgx.Conn) *BookingGroupRepo {
+func NewBookingGroupRepo(db *pgxpool.Pool, logger *slog.Logger) *BookingGroupRepo {
 	return &BookingGroupRepo{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 
 // CreateBookingGroup creates a new booking group record if capacity allows.
 func (r *BookingGroupRepo) CreateBookingGroup(ctx context.Context, req *booking.CreateBookingGroupRequest) (*booking.BookingGroup, error) {
-	// 1. Get the subscription capacity
-	var capacity int
-	err := r.db.QueryRow(ctx, "SELECT capacity FROM subscription_group WHERE id = $1", req.BookingGroup.SubscriptionId).Scan(&capacity)
+	ctx, span := tracing.Start(ctx, "BookingGroupRepo.CreateBookingGroup")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
 	if err != nil {
-		return nil, fmt.Errorf("error getting subscription capacity: %w", err)
+		return nil, err
 	}
+	defer tx.Rollback(ctx)
 
-	// 2. Count existing active bookings for the subscription
-	var activeBookings int
-	err = r.db.QueryRow(ctx, `
-		SELECT COUNT(*) 
-		FROM booking_group 
-		WHERE subscription_id = $1 AND access_status = 'granted' AND start_date <= NOW() AND start_date + (
-			SELECT duration * INTERVAL '1 hour' FROM subscription_group WHERE id = $1
-		) > NOW()
-	`, req.BookingGroup.SubscriptionId).Scan(&activeBookings)
-	if err != nil {
-		return nil, fmt.Errorf("error counting active bookings: %w", err)
+	if err := checkSubscriptionGender(ctx, tx, subscriptionTypeGroup, req.BookingGroup.SubscriptionId, req.BookingGroup.UserId); err != nil {
+		return nil, err
 	}
 
-	// 3. Check if capacity allows new booking
-	if activeBookings >= capacity {
-		return nil, fmt.Errorf("group is full, capacity reached")
+	// 1. Check that the group has a free place
+	if err := checkGroupCapacity(ctx, tx, req.BookingGroup.SubscriptionId); err != nil {
+		return nil, err
 	}
 
-	// 4. Create the booking if capacity allows
+	// 2. Create the booking
 	req.BookingGroup.Id = uuid.New().String()
 	query := `
 		INSERT INTO booking_group (
@@ -60,10 +61,11 @@ func (r *BookingGroupRepo) CreateBookingGroup(ctx context.Context, req *booking.
 			access_status,
 			start_date,
 			count,
+			subscription_version,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT version FROM subscription_group WHERE id = $3), NOW(), NOW())
+		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, subscription_version, created_at, updated_at
 	`
 
 	var (
@@ -72,7 +74,7 @@ func (r *BookingGroupRepo) CreateBookingGroup(ctx context.Context, req *booking.
 		updatedAt time.Time
 	)
 
-	err = r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.BookingGroup.Id,
 		req.BookingGroup.UserId,
 		req.BookingGroup.SubscriptionId,
@@ -88,6 +90,7 @@ func (r *BookingGroupRepo) CreateBookingGroup(ctx context.Context, req *booking.
 		&req.BookingGroup.AccessStatus,
 		&startDate,
 		&req.BookingGroup.Count,
+		&req.BookingGroup.SubscriptionVersion,
 		&createdAt,
 		&updatedAt,
 	)
@@ -100,6 +103,15 @@ func (r *BookingGroupRepo) CreateBookingGroup(ctx context.Context, req *booking.
 	req.BookingGroup.CreatedAt = createdAt.Format(time.RFC3339)
 	req.BookingGroup.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCreated, subscriptionTypeGroup, req.BookingGroup.Id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	metrics.BookingsCreated.WithLabelValues(subscriptionTypeGroup).Inc()
+
 	return req.BookingGroup, nil
 }
 
@@ -160,6 +172,9 @@ func (r *BookingGroupRepo) CreateBookingGroup(ctx context.Context, req *booking.
 
 // GetBookingGroup retrieves a booking group record by ID.
 func (r *BookingGroupRepo) GetBookingGroup(ctx context.Context, req *booking.GetBookingGroupRequest) (*booking.BookingGroup, error) {
+	ctx, span := tracing.Start(ctx, "BookingGroupRepo.GetBookingGroup")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -169,6 +184,8 @@ func (r *BookingGroupRepo) GetBookingGroup(ctx context.Context, req *booking.Get
 			access_status,
 			start_date,
 			count,
+			subscription_version,
+			COALESCE(bundle_purchase_id::text, ''),
 			created_at,
 			updated_at
 		FROM booking_group
@@ -190,6 +207,8 @@ func (r *BookingGroupRepo) GetBookingGroup(ctx context.Context, req *booking.Get
 		&booking.AccessStatus,
 		&startDate,
 		&booking.Count,
+		&booking.SubscriptionVersion,
+		&booking.BundlePurchaseId,
 		&createdAt,
 		&updatedAt,
 	)
@@ -210,6 +229,15 @@ func (r *BookingGroupRepo) GetBookingGroup(ctx context.Context, req *booking.Get
 
 // UpdateBookingGroup updates an existing booking group record.
 func (r *BookingGroupRepo) UpdateBookingGroup(ctx context.Context, req *booking.UpdateBookingGroupRequest) (*booking.BookingGroup, error) {
+	ctx, span := tracing.Start(ctx, "BookingGroupRepo.UpdateBookingGroup")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		UPDATE booking_group
 		SET
@@ -219,9 +247,13 @@ func (r *BookingGroupRepo) UpdateBookingGroup(ctx context.Context, req *booking.
 			access_status = $4,
 			start_date = $5,
 			count = $6,
+			subscription_version = CASE
+				WHEN subscription_id = $2 THEN subscription_version
+				ELSE (SELECT version FROM subscription_group WHERE id = $2)
+			END,
 			updated_at = NOW()
 		WHERE id = $7
-		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, created_at, updated_at
+		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, subscription_version, created_at, updated_at
 	`
 
 	var (
@@ -230,7 +262,7 @@ func (r *BookingGroupRepo) UpdateBookingGroup(ctx context.Context, req *booking.
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.BookingGroup.UserId,
 		req.BookingGroup.SubscriptionId,
 		req.BookingGroup.Payment,
@@ -246,6 +278,7 @@ func (r *BookingGroupRepo) UpdateBookingGroup(ctx context.Context, req *booking.
 		&req.BookingGroup.AccessStatus,
 		&startDate,
 		&req.BookingGroup.Count,
+		&req.BookingGroup.SubscriptionVersion,
 		&createdAt,
 		&updatedAt,
 	)
@@ -261,17 +294,34 @@ func (r *BookingGroupRepo) UpdateBookingGroup(ctx context.Context, req *booking.
 	req.BookingGroup.CreatedAt = createdAt.Format(time.RFC3339)
 	req.BookingGroup.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return req.BookingGroup, nil
 }
 
 // DeleteBookingGroup deletes a booking group record by ID.
 func (r *BookingGroupRepo) DeleteBookingGroup(ctx context.Context, req *booking.DeleteBookingGroupRequest) error {
+	ctx, span := tracing.Start(ctx, "BookingGroupRepo.DeleteBookingGroup")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCancelled, subscriptionTypeGroup, req.Id); err != nil {
+		return err
+	}
+
 	query := `
 		DELETE FROM booking_group
 		WHERE id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, req.Id)
+	result, err := tx.Exec(ctx, query, req.Id)
 	if err != nil {
 		return err
 	}
@@ -280,11 +330,18 @@ func (r *BookingGroupRepo) DeleteBookingGroup(ctx context.Context, req *booking.
 		return pgx.ErrNoRows
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ListBookingGroup retrieves a list of booking group records with optional filtering.
 func (r *BookingGroupRepo) ListBookingGroup(ctx context.Context, req *booking.ListBookingGroupRequest) (*booking.ListBookingGroupResponse, error) {
+	ctx, span := tracing.Start(ctx, "BookingGroupRepo.ListBookingGroup")
+	defer span.End()
+
 	var args []interface{}
 	count := 1
 	query := `
@@ -296,6 +353,8 @@ func (r *BookingGroupRepo) ListBookingGroup(ctx context.Context, req *booking.Li
 			access_status,
 			start_date,
 			count,
+			subscription_version,
+			COALESCE(bundle_purchase_id::text, ''),
 			created_at,
 			updated_at
 		FROM booking_group
@@ -332,6 +391,8 @@ func (r *BookingGroupRepo) ListBookingGroup(ctx context.Context, req *booking.Li
 			&booking.AccessStatus,
 			&startDate,
 			&booking.Count,
+			&booking.SubscriptionVersion,
+			&booking.BundlePurchaseId,
 			&createdAt,
 			&updatedAt,
 		)
@@ -349,3 +410,36 @@ func (r *BookingGroupRepo) ListBookingGroup(ctx context.Context, req *booking.Li
 
 	return &booking.ListBookingGroupResponse{BookingGroup: bookings}, nil
 }
+
+// checkGroupCapacity fails when a group plan has no free places left. A
+// booking holds a place while its pinned version's duration, in days as the
+// access trigger counts it, has not run out. The plan row is locked so
+// concurrent bookings cannot overbook it.
+func checkGroupCapacity(ctx context.Context, q querier, subscriptionID string) error {
+	var capacity int
+	err := q.QueryRow(ctx, `SELECT capacity FROM subscription_group WHERE id = $1 FOR UPDATE`, subscriptionID).Scan(&capacity)
+	if err != nil {
+		return fmt.Errorf("error getting subscription capacity: %w", err)
+	}
+
+	var activeBookings int
+	err = q.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM booking_group bg
+		JOIN subscription_versions v ON v.subscription_type = 'group'
+			AND v.subscription_id = bg.subscription_id
+			AND v.version = bg.subscription_version
+		WHERE bg.subscription_id = $1 AND bg.access_status = 'granted'
+			AND bg.start_date <= NOW() AND bg.start_date + v.duration * INTERVAL '1 day' > NOW()
+	`, subscriptionID).Scan(&activeBookings)
+	if err != nil {
+		return fmt.Errorf("error counting active bookings: %w", err)
+	}
+
+	if activeBookings >= capacity {
+		metrics.GroupCapacityRejections.Inc()
+		return storage.Errorf(storage.ErrFailedPrecondition, "group is full, capacity reached")
+	}
+
+	return nil
+}
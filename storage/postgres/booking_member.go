@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// bookingTable names the tables holding one booking type and its visits,
+// and the unit its plan duration is measured in.
+type bookingTable struct {
+	booking      string
+	access       string
+	durationUnit string
+}
+
+// bookingTables maps a booking type to its tables.
+var bookingTables = map[string]bookingTable{
+	subscriptionTypePersonal: {booking: "booking_personal", access: "access_personal", durationUnit: "1 day"},
+	subscriptionTypeGroup:    {booking: "booking_group", access: "access_group", durationUnit: "1 day"},
+	subscriptionTypeCoach:    {booking: "booking_coach", access: "access_coach", durationUnit: "1 hour"},
+}
+
+// lookupBookingTable returns the tables for a booking type.
+func lookupBookingTable(bookingType string) (bookingTable, error) {
+	tables, ok := bookingTables[bookingType]
+	if !ok {
+		return bookingTable{}, storage.Errorf(storage.ErrInvalidArgument, "invalid booking type %q", bookingType)
+	}
+	return tables, nil
+}
+
+// BookingMemberRepo implements the BookingMemberRepoI interface for shared bookings.
+type BookingMemberRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewBookingMemberRepo creates a new BookingMemberRepo.
+func NewBookingMemberRepo(db *pgxpool.Pool, logger *slog.Logger) *BookingMemberRepo {
+	return &BookingMemberRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddBookingMember adds a member to a booking, or updates the member's visit
+// limit if they already share it. Only the account holder, calling for
+// themselves, may add members.
+func (r *BookingMemberRepo) AddBookingMember(ctx context.Context, req *booking.AddBookingMemberRequest) (*booking.BookingMember, error) {
+	ctx, span := tracing.Start(ctx, "BookingMemberRepo.AddBookingMember")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	member := req.BookingMember
+	holderID, err := checkHolder(ctx, tx, member.BookingType, member.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	if member.UserId == holderID {
+		return nil, storage.Errorf(storage.ErrAlreadyExists, "account holder is already on the booking")
+	}
+
+	query := `
+		INSERT INTO booking_members (
+			booking_id,
+			booking_type,
+			user_id,
+			visit_limit,
+			created_at
+		) VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (booking_type, booking_id, user_id)
+		DO UPDATE SET visit_limit = EXCLUDED.visit_limit
+		RETURNING booking_id, booking_type, user_id, visit_limit, created_at
+	`
+
+	var createdAt time.Time
+
+	err = tx.QueryRow(ctx, query,
+		member.BookingId,
+		member.BookingType,
+		member.UserId,
+		member.VisitLimit,
+	).Scan(
+		&member.BookingId,
+		&member.BookingType,
+		&member.UserId,
+		&member.VisitLimit,
+		&createdAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	member.CreatedAt = createdAt.Format(time.RFC3339)
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// RemoveBookingMember removes a member from a booking. Only the account holder,
+// calling for themselves, may remove members.
+func (r *BookingMemberRepo) RemoveBookingMember(ctx context.Context, req *booking.RemoveBookingMemberRequest) error {
+	ctx, span := tracing.Start(ctx, "BookingMemberRepo.RemoveBookingMember")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := checkHolder(ctx, tx, req.BookingType, req.BookingId); err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM booking_members
+		WHERE booking_type = $1 AND booking_id = $2 AND user_id = $3
+	`
+
+	result, err := tx.Exec(ctx, query, req.BookingType, req.BookingId, req.UserId)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListBookingMembers retrieves the members of a booking with their used visits.
+func (r *BookingMemberRepo) ListBookingMembers(ctx context.Context, req *booking.ListBookingMembersRequest) (*booking.ListBookingMembersResponse, error) {
+	ctx, span := tracing.Start(ctx, "BookingMemberRepo.ListBookingMembers")
+	defer span.End()
+
+	tables, err := lookupBookingTable(req.BookingType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			bm.booking_id,
+			bm.booking_type,
+			bm.user_id,
+			bm.visit_limit,
+			(SELECT COUNT(*) FROM %s a WHERE a.booking_id = bm.booking_id AND a.user_id = bm.user_id),
+			bm.created_at
+		FROM booking_members bm
+		WHERE bm.booking_type = $1 AND bm.booking_id = $2
+		ORDER BY bm.created_at
+	`, tables.access)
+
+	rows, err := r.db.Query(ctx, query, req.BookingType, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*booking.BookingMember
+
+	for rows.Next() {
+		var (
+			member    booking.BookingMember
+			createdAt time.Time
+		)
+
+		err := rows.Scan(
+			&member.BookingId,
+			&member.BookingType,
+			&member.UserId,
+			&member.VisitLimit,
+			&member.VisitsUsed,
+			&createdAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		member.CreatedAt = createdAt.Format(time.RFC3339)
+
+		members = append(members, &member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &booking.ListBookingMembersResponse{BookingMembers: members}, nil
+}
+
+// checkHolder verifies that the caller of the request is the account holder
+// of the booking and returns their user ID. Run inside the transaction
+// changing the members, it locks the booking so the holder cannot change
+// through a transfer before the change commits.
+func checkHolder(ctx context.Context, q querier, bookingType, bookingID string) (string, error) {
+	tables, err := lookupBookingTable(bookingType)
+	if err != nil {
+		return "", err
+	}
+
+	callerID := audit.FromContext(ctx).Actor
+	if callerID == "" {
+		return "", storage.Errorf(storage.ErrUnauthenticated, "booking members are managed by the calling account holder")
+	}
+
+	var holderID string
+	query := fmt.Sprintf(`SELECT user_id FROM %s WHERE id = $1 FOR SHARE`, tables.booking)
+	if err := q.QueryRow(ctx, query, bookingID).Scan(&holderID); err != nil {
+		return "", fmt.Errorf("error getting booking holder: %w", err)
+	}
+	if holderID != callerID {
+		return "", storage.Errorf(storage.ErrPermissionDenied, "only the account holder can manage booking members")
+	}
+	return holderID, nil
+}
+
+// checkBookingMember verifies that userID may use the booking, either as its
+// account holder or as a member with visits left on their own limit.
+func checkBookingMember(ctx context.Context, db *pgxpool.Pool, bookingType, bookingID, userID string) error {
+	tables, err := lookupBookingTable(bookingType)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			b.user_id = $2,
+			bm.user_id IS NOT NULL,
+			COALESCE(bm.visit_limit, 0),
+			(SELECT COUNT(*) FROM %s a WHERE a.booking_id = b.id AND a.user_id = $2)
+		FROM %s b
+		LEFT JOIN booking_members bm ON bm.booking_type = $3 AND bm.booking_id = b.id AND bm.user_id = $2
+		WHERE b.id = $1
+	`, tables.access, tables.booking)
+
+	var (
+		isHolder   bool
+		isMember   bool
+		visitLimit int32
+		visitsUsed int32
+	)
+	err = db.QueryRow(ctx, query, bookingID, userID, bookingType).Scan(&isHolder, &isMember, &visitLimit, &visitsUsed)
+	if err != nil {
+		return fmt.Errorf("error checking booking member: %w", err)
+	}
+
+	if isHolder {
+		return nil
+	}
+	if !isMember {
+		return storage.Errorf(storage.ErrFailedPrecondition, "access denied: user is not a member of this booking")
+	}
+	if visitLimit > 0 && visitsUsed >= visitLimit {
+		return storage.Errorf(storage.ErrFailedPrecondition, "access denied: member visit limit reached")
+	}
+	return nil
+}
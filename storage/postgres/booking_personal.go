@@ -2,28 +2,53 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"log/slog"
+	"math"
 	"time"
 
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/events"
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/helper"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // BookingPersonalRepo implements the BookingRepoI interface for BookingPersonal entities.
 type BookingPersonalRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
 // NewBookingPersonalRepo creates a new BookingPersonalRepo.
-func NewBookingPersonalRepo(db *pgx.Conn) *BookingPersonalRepo {
+func NewBookingPersonalRepo(db *pgxpool.Pool, logger *slog.Logger) *BookingPersonalRepo {
 	return &BookingPersonalRepo{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 
 // CreateBookingPersonal creates a new booking personal record.
 func (r *BookingPersonalRepo) CreateBookingPersonal(ctx context.Context, req *booking.CreateBookingPersonalRequest) (*booking.BookingPersonal, error) {
+	ctx, span := tracing.Start(ctx, "BookingPersonalRepo.CreateBookingPersonal")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := checkSubscriptionGender(ctx, tx, subscriptionTypePersonal, req.BookingPersonal.SubscriptionId, req.BookingPersonal.UserId); err != nil {
+		return nil, err
+	}
+
 	req.BookingPersonal.Id = uuid.New().String()
 	query := `
 		INSERT INTO booking_personal (
@@ -34,10 +59,11 @@ func (r *BookingPersonalRepo) CreateBookingPersonal(ctx context.Context, req *bo
 			access_status,
 			start_date,
 			count,
+			subscription_version,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, (SELECT version FROM subscription_personal WHERE id = $3), NOW(), NOW())
+		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, subscription_version, created_at, updated_at
 	`
 
 	var (
@@ -46,7 +72,7 @@ func (r *BookingPersonalRepo) CreateBookingPersonal(ctx context.Context, req *bo
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.BookingPersonal.Id,
 		req.BookingPersonal.UserId,
 		req.BookingPersonal.SubscriptionId,
@@ -62,6 +88,7 @@ func (r *BookingPersonalRepo) CreateBookingPersonal(ctx context.Context, req *bo
 		&req.BookingPersonal.AccessStatus,
 		&startDate,
 		&req.BookingPersonal.Count,
+		&req.BookingPersonal.SubscriptionVersion,
 		&createdAt,
 		&updatedAt,
 	)
@@ -74,11 +101,23 @@ func (r *BookingPersonalRepo) CreateBookingPersonal(ctx context.Context, req *bo
 	req.BookingPersonal.CreatedAt = createdAt.Format(time.RFC3339)
 	req.BookingPersonal.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCreated, subscriptionTypePersonal, req.BookingPersonal.Id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	metrics.BookingsCreated.WithLabelValues(subscriptionTypePersonal).Inc()
+
 	return req.BookingPersonal, nil
 }
 
 // GetBookingPersonal retrieves a booking personal record by ID.
 func (r *BookingPersonalRepo) GetBookingPersonal(ctx context.Context, req *booking.GetBookingPersonalRequest) (*booking.BookingPersonal, error) {
+	ctx, span := tracing.Start(ctx, "BookingPersonalRepo.GetBookingPersonal")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -88,6 +127,10 @@ func (r *BookingPersonalRepo) GetBookingPersonal(ctx context.Context, req *booki
 			access_status,
 			start_date,
 			count,
+			subscription_version,
+			closed_at,
+			COALESCE(closed_reason, ''),
+			COALESCE(bundle_purchase_id::text, ''),
 			created_at,
 			updated_at
 		FROM booking_personal
@@ -97,6 +140,7 @@ func (r *BookingPersonalRepo) GetBookingPersonal(ctx context.Context, req *booki
 	var (
 		booking   booking.BookingPersonal
 		startDate time.Time
+		closedAt  sql.NullTime
 		createdAt time.Time
 		updatedAt time.Time
 	)
@@ -109,6 +153,10 @@ func (r *BookingPersonalRepo) GetBookingPersonal(ctx context.Context, req *booki
 		&booking.AccessStatus,
 		&startDate,
 		&booking.Count,
+		&booking.SubscriptionVersion,
+		&closedAt,
+		&booking.ClosedReason,
+		&booking.BundlePurchaseId,
 		&createdAt,
 		&updatedAt,
 	)
@@ -121,6 +169,7 @@ func (r *BookingPersonalRepo) GetBookingPersonal(ctx context.Context, req *booki
 	}
 
 	booking.StartDate = startDate.Format(time.RFC3339)
+	booking.ClosedAt = helper.DateToString(closedAt)
 	booking.CreatedAt = createdAt.Format(time.RFC3339)
 	booking.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -129,6 +178,15 @@ func (r *BookingPersonalRepo) GetBookingPersonal(ctx context.Context, req *booki
 
 // UpdateBookingPersonal updates an existing booking personal record.
 func (r *BookingPersonalRepo) UpdateBookingPersonal(ctx context.Context, req *booking.UpdateBookingPersonalRequest) (*booking.BookingPersonal, error) {
+	ctx, span := tracing.Start(ctx, "BookingPersonalRepo.UpdateBookingPersonal")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		UPDATE booking_personal
 		SET
@@ -138,9 +196,13 @@ func (r *BookingPersonalRepo) UpdateBookingPersonal(ctx context.Context, req *bo
 			access_status = $4,
 			start_date = $5,
 			count = $6,
+			subscription_version = CASE
+				WHEN subscription_id = $2 THEN subscription_version
+				ELSE (SELECT version FROM subscription_personal WHERE id = $2)
+			END,
 			updated_at = NOW()
 		WHERE id = $7
-		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, created_at, updated_at
+		RETURNING id, user_id, subscription_id, payment, access_status, start_date, count, subscription_version, created_at, updated_at
 	`
 
 	var (
@@ -149,7 +211,7 @@ func (r *BookingPersonalRepo) UpdateBookingPersonal(ctx context.Context, req *bo
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.BookingPersonal.UserId,
 		req.BookingPersonal.SubscriptionId,
 		req.BookingPersonal.Payment,
@@ -165,6 +227,7 @@ func (r *BookingPersonalRepo) UpdateBookingPersonal(ctx context.Context, req *bo
 		&req.BookingPersonal.AccessStatus,
 		&startDate,
 		&req.BookingPersonal.Count,
+		&req.BookingPersonal.SubscriptionVersion,
 		&createdAt,
 		&updatedAt,
 	)
@@ -180,17 +243,34 @@ func (r *BookingPersonalRepo) UpdateBookingPersonal(ctx context.Context, req *bo
 	req.BookingPersonal.CreatedAt = createdAt.Format(time.RFC3339)
 	req.BookingPersonal.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return req.BookingPersonal, nil
 }
 
 // DeleteBookingPersonal deletes a booking personal record by ID.
 func (r *BookingPersonalRepo) DeleteBookingPersonal(ctx context.Context, req *booking.DeleteBookingPersonalRequest) error {
+	ctx, span := tracing.Start(ctx, "BookingPersonalRepo.DeleteBookingPersonal")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCancelled, subscriptionTypePersonal, req.Id); err != nil {
+		return err
+	}
+
 	query := `
 		DELETE FROM booking_personal
 		WHERE id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, req.Id)
+	result, err := tx.Exec(ctx, query, req.Id)
 	if err != nil {
 		return err
 	}
@@ -199,11 +279,18 @@ func (r *BookingPersonalRepo) DeleteBookingPersonal(ctx context.Context, req *bo
 		return pgx.ErrNoRows
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ListBookingPersonal retrieves a list of booking personal records with optional filtering.
 func (r *BookingPersonalRepo) ListBookingPersonal(ctx context.Context, req *booking.ListBookingPersonalRequest) (*booking.ListBookingPersonalResponse, error) {
+	ctx, span := tracing.Start(ctx, "BookingPersonalRepo.ListBookingPersonal")
+	defer span.End()
+
 	var args []interface{}
 	count := 1
 	query := `
@@ -215,6 +302,10 @@ func (r *BookingPersonalRepo) ListBookingPersonal(ctx context.Context, req *book
 			access_status,
 			start_date,
 			count,
+			subscription_version,
+			closed_at,
+			COALESCE(closed_reason, ''),
+			COALESCE(bundle_purchase_id::text, ''),
 			created_at,
 			updated_at
 		FROM booking_personal
@@ -239,6 +330,7 @@ func (r *BookingPersonalRepo) ListBookingPersonal(ctx context.Context, req *book
 		var (
 			booking   booking.BookingPersonal
 			startDate time.Time
+			closedAt  sql.NullTime
 			createdAt time.Time
 			updatedAt time.Time
 		)
@@ -251,6 +343,10 @@ func (r *BookingPersonalRepo) ListBookingPersonal(ctx context.Context, req *book
 			&booking.AccessStatus,
 			&startDate,
 			&booking.Count,
+			&booking.SubscriptionVersion,
+			&closedAt,
+			&booking.ClosedReason,
+			&booking.BundlePurchaseId,
 			&createdAt,
 			&updatedAt,
 		)
@@ -260,6 +356,7 @@ func (r *BookingPersonalRepo) ListBookingPersonal(ctx context.Context, req *book
 		}
 
 		booking.StartDate = startDate.Format(time.RFC3339)
+		booking.ClosedAt = helper.DateToString(closedAt)
 		booking.CreatedAt = createdAt.Format(time.RFC3339)
 		booking.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -272,3 +369,214 @@ func (r *BookingPersonalRepo) ListBookingPersonal(ctx context.Context, req *book
 
 	return &booking.ListBookingPersonalResponse{BookingPersonal: bookings}, nil
 }
+
+// ChangePlan moves a user from their current personal booking to a new plan.
+// The unused part of the current booking is credited against the new plan's
+// price: the credit is the payment scaled by whichever is smaller of the
+// unused days and the unused visits. The new booking is created, the old one
+// is closed and the change is recorded, all in one transaction. Only the
+// account holder, calling for themselves, may change the plan, and the new
+// booking gets the visit limit of the plan version it is sold under.
+func (r *BookingPersonalRepo) ChangePlan(ctx context.Context, req *booking.ChangePlanRequest) (*booking.ChangePlanResponse, error) {
+	ctx, span := tracing.Start(ctx, "BookingPersonalRepo.ChangePlan")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. Lock the current booking and read the terms it was sold under
+	var (
+		holderID       string
+		subscriptionID string
+		payment        int32
+		count          int32
+		startDate      time.Time
+		closedAt       sql.NullTime
+		duration       int32
+		visits         int32
+		visitsUsed     int32
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT
+			b.user_id,
+			b.subscription_id,
+			b.payment,
+			b.count,
+			b.start_date,
+			b.closed_at,
+			v.duration,
+			COALESCE(v.count, 0),
+			(SELECT COUNT(*) FROM access_personal a WHERE a.booking_id = b.id)
+		FROM booking_personal b
+		JOIN subscription_versions v
+			ON v.subscription_type = 'personal' AND v.subscription_id = b.subscription_id AND v.version = b.subscription_version
+		WHERE b.id = $1
+		FOR UPDATE OF b
+	`, req.BookingId).Scan(
+		&holderID,
+		&subscriptionID,
+		&payment,
+		&count,
+		&startDate,
+		&closedAt,
+		&duration,
+		&visits,
+		&visitsUsed,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting booking: %w", err)
+	}
+
+	callerID := audit.FromContext(ctx).Actor
+	if callerID == "" {
+		return nil, storage.Errorf(storage.ErrUnauthenticated, "plans are changed by the calling account holder")
+	}
+	if holderID != callerID {
+		return nil, storage.Errorf(storage.ErrPermissionDenied, "only the account holder can change the plan")
+	}
+	if closedAt.Valid {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "booking is already closed")
+	}
+	if subscriptionID == req.NewSubscriptionId {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "booking is already on this plan")
+	}
+
+	if count == -1 {
+		visits = 0
+	}
+	credit := proratedCredit(payment, duration, visits, visitsUsed, startDate, time.Now())
+
+	// 2. Check the credit and extra payment cover the current version of the
+	// new plan
+	var (
+		newGymID   string
+		oldGymID   string
+		newVersion int32
+		newPrice   int32
+		newCount   int32
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT n.gym_id, o.gym_id, v.version, v.price, COALESCE(v.count, 0)
+		FROM subscription_personal n
+		JOIN subscription_versions v
+			ON v.subscription_type = 'personal' AND v.subscription_id = n.id AND v.version = n.version
+		CROSS JOIN subscription_personal o
+		WHERE n.id = $1 AND o.id = $2
+	`, req.NewSubscriptionId, subscriptionID).Scan(&newGymID, &oldGymID, &newVersion, &newPrice, &newCount)
+	if err != nil {
+		return nil, fmt.Errorf("error getting new plan: %w", err)
+	}
+	if newGymID != oldGymID {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "new plan belongs to another gym")
+	}
+	if credit+req.ExtraPayment < newPrice {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "credit of %d and payment of %d do not cover the plan price of %d", credit, req.ExtraPayment, newPrice)
+	}
+
+	// A plan without a visit count has no visit limit, which bookings mark
+	// with a count of -1
+	if newCount <= 0 {
+		newCount = -1
+	}
+
+	// 3. Close the old booking and create the new one
+	_, err = tx.Exec(ctx, `
+		UPDATE booking_personal
+		SET closed_at = NOW(), closed_reason = 'plan_change', updated_at = NOW()
+		WHERE id = $1
+	`, req.BookingId)
+	if err != nil {
+		return nil, fmt.Errorf("error closing booking: %w", err)
+	}
+
+	newBookingID := uuid.New().String()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO booking_personal (
+			id,
+			user_id,
+			subscription_id,
+			payment,
+			start_date,
+			count,
+			subscription_version,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, '')::timestamp, NOW()), $6, $7, NOW(), NOW())
+	`,
+		newBookingID,
+		holderID,
+		req.NewSubscriptionId,
+		credit+req.ExtraPayment,
+		req.StartDate,
+		newCount,
+		newVersion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new booking: %w", err)
+	}
+	if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCreated, subscriptionTypePersonal, newBookingID); err != nil {
+		return nil, err
+	}
+
+	// 4. Record the change
+	_, err = tx.Exec(ctx, `
+		INSERT INTO booking_plan_changes (
+			id,
+			user_id,
+			old_booking_id,
+			new_booking_id,
+			credit,
+			extra_payment,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	`, uuid.New().String(), holderID, req.BookingId, newBookingID, credit, req.ExtraPayment)
+	if err != nil {
+		return nil, fmt.Errorf("error recording plan change: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	metrics.BookingsCreated.WithLabelValues(subscriptionTypePersonal).Inc()
+
+	oldBooking, err := r.GetBookingPersonal(ctx, &booking.GetBookingPersonalRequest{Id: req.BookingId})
+	if err != nil {
+		return nil, err
+	}
+	newBooking, err := r.GetBookingPersonal(ctx, &booking.GetBookingPersonalRequest{Id: newBookingID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &booking.ChangePlanResponse{
+		OldBooking: oldBooking,
+		NewBooking: newBooking,
+		Credit:     credit,
+	}, nil
+}
+
+// proratedCredit returns the part of payment not yet used up. Days and visits
+// are both considered and the smaller unused share wins, so a member cannot
+// get credit for days they already spent or for visits they already made.
+// A visits value of 0 means the plan has no visit limit.
+func proratedCredit(payment, duration, visits, visitsUsed int32, startDate, now time.Time) int32 {
+	if payment <= 0 || duration <= 0 {
+		return 0
+	}
+
+	share := 1.0
+
+	total := time.Duration(duration) * 24 * time.Hour
+	if elapsed := now.Sub(startDate); elapsed > 0 {
+		share = math.Max(0, float64(total-elapsed)/float64(total))
+	}
+
+	if visits > 0 {
+		share = math.Min(share, math.Max(0, float64(visits-visitsUsed)/float64(visits)))
+	}
+
+	return int32(math.Floor(float64(payment) * share))
+}
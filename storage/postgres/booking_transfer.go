@@ -0,0 +1,372 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/helper"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BookingTransferRepo implements the BookingTransferRepoI interface.
+type BookingTransferRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewBookingTransferRepo creates a new BookingTransferRepo.
+func NewBookingTransferRepo(db *pgxpool.Pool, logger *slog.Logger) *BookingTransferRepo {
+	return &BookingTransferRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// SetTransferRule creates or replaces the transfer rule of a plan.
+func (r *BookingTransferRepo) SetTransferRule(ctx context.Context, req *booking.SetTransferRuleRequest) (*booking.TransferRule, error) {
+	ctx, span := tracing.Start(ctx, "BookingTransferRepo.SetTransferRule")
+	defer span.End()
+
+	rule := req.TransferRule
+	if _, err := lookupBookingTable(rule.SubscriptionType); err != nil {
+		return nil, err
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO subscription_transfer_rules (
+			subscription_id,
+			subscription_type,
+			transferable,
+			fee,
+			max_transfers,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (subscription_type, subscription_id)
+		DO UPDATE SET
+			transferable = EXCLUDED.transferable,
+			fee = EXCLUDED.fee,
+			max_transfers = EXCLUDED.max_transfers,
+			updated_at = NOW()
+		RETURNING subscription_id, subscription_type, transferable, fee, max_transfers, updated_at
+	`
+
+	var updatedAt time.Time
+
+	err = tx.QueryRow(ctx, query,
+		rule.SubscriptionId,
+		rule.SubscriptionType,
+		rule.Transferable,
+		rule.Fee,
+		rule.MaxTransfers,
+	).Scan(
+		&rule.SubscriptionId,
+		&rule.SubscriptionType,
+		&rule.Transferable,
+		&rule.Fee,
+		&rule.MaxTransfers,
+		&updatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rule.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetTransferRule retrieves the transfer rule of a plan. Plans without a rule
+// are not transferable.
+func (r *BookingTransferRepo) GetTransferRule(ctx context.Context, req *booking.GetTransferRuleRequest) (*booking.TransferRule, error) {
+	ctx, span := tracing.Start(ctx, "BookingTransferRepo.GetTransferRule")
+	defer span.End()
+
+	return getTransferRule(ctx, r.db, req.SubscriptionType, req.SubscriptionId)
+}
+
+// TransferBooking hands the remaining validity and visits of a booking over to
+// another user. The booking row keeps its dates and visit history, so nothing
+// is reset; only the holder changes and shared members are dropped. The
+// transfer is recorded in booking_transfers. Only the calling account holder
+// can transfer their booking.
+func (r *BookingTransferRepo) TransferBooking(ctx context.Context, req *booking.TransferBookingRequest) (*booking.BookingTransfer, error) {
+	ctx, span := tracing.Start(ctx, "BookingTransferRepo.TransferBooking")
+	defer span.End()
+
+	tables, err := lookupBookingTable(req.BookingType)
+	if err != nil {
+		return nil, err
+	}
+	fromUserID := audit.FromContext(ctx).Actor
+	if fromUserID == "" {
+		return nil, storage.Errorf(storage.ErrUnauthenticated, "bookings are transferred by the calling account holder")
+	}
+	if req.ToUserId == "" || req.ToUserId == fromUserID {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "booking must be transferred to another user")
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. Lock the booking and work out what is left on it
+	var (
+		holderID        string
+		subscriptionID  string
+		accessStatus    string
+		validUntil      time.Time
+		remainingVisits int32
+	)
+	query := fmt.Sprintf(`
+		SELECT
+			b.user_id,
+			b.subscription_id,
+			b.access_status,
+			b.start_date + (v.duration * INTERVAL '%s'),
+			CASE
+				WHEN b.count = -1 THEN -1
+				ELSE GREATEST(COALESCE(v.count, 0) - (SELECT COUNT(*) FROM %s a WHERE a.booking_id = b.id), 0)
+			END
+		FROM %s b
+		JOIN subscription_versions v
+			ON v.subscription_type = $2 AND v.subscription_id = b.subscription_id AND v.version = b.subscription_version
+		WHERE b.id = $1
+		FOR UPDATE OF b
+	`, tables.durationUnit, tables.access, tables.booking)
+	err = tx.QueryRow(ctx, query, req.BookingId, req.BookingType).Scan(
+		&holderID,
+		&subscriptionID,
+		&accessStatus,
+		&validUntil,
+		&remainingVisits,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting booking: %w", err)
+	}
+
+	if holderID != fromUserID {
+		return nil, storage.Errorf(storage.ErrPermissionDenied, "only the account holder can transfer a booking")
+	}
+	if accessStatus != "granted" || !validUntil.After(time.Now()) {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "booking has no remaining validity to transfer")
+	}
+
+	// 2. Enforce the plan's transfer rule
+	rule, err := getTransferRule(ctx, tx, req.BookingType, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !rule.Transferable {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "bookings of this plan are not transferable")
+	}
+	if req.FeePayment < rule.Fee {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "transfer fee of %d is not paid", rule.Fee)
+	}
+	if rule.MaxTransfers > 0 {
+		var transfers int32
+		err := tx.QueryRow(ctx, `
+			SELECT COUNT(*) FROM booking_transfers WHERE booking_type = $1 AND booking_id = $2
+		`, req.BookingType, req.BookingId).Scan(&transfers)
+		if err != nil {
+			return nil, fmt.Errorf("error counting booking transfers: %w", err)
+		}
+		if transfers >= rule.MaxTransfers {
+			return nil, storage.Errorf(storage.ErrFailedPrecondition, "booking has reached the maximum of %d transfers", rule.MaxTransfers)
+		}
+	}
+
+	if err := checkSubscriptionGender(ctx, tx, req.BookingType, subscriptionID, req.ToUserId); err != nil {
+		return nil, err
+	}
+
+	// 3. Move the booking to the new holder
+	query = fmt.Sprintf(`UPDATE %s SET user_id = $1, updated_at = NOW() WHERE id = $2`, tables.booking)
+	if _, err := tx.Exec(ctx, query, req.ToUserId, req.BookingId); err != nil {
+		return nil, fmt.Errorf("error transferring booking: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM booking_members WHERE booking_type = $1 AND booking_id = $2`, req.BookingType, req.BookingId)
+	if err != nil {
+		return nil, fmt.Errorf("error removing booking members: %w", err)
+	}
+
+	// 4. Record the transfer
+	transfer := booking.BookingTransfer{
+		Id:              uuid.New().String(),
+		BookingId:       req.BookingId,
+		BookingType:     req.BookingType,
+		FromUserId:      fromUserID,
+		ToUserId:        req.ToUserId,
+		FeePaid:         req.FeePayment,
+		RemainingVisits: remainingVisits,
+		ValidUntil:      validUntil.Format(time.RFC3339),
+	}
+
+	var createdAt time.Time
+	err = tx.QueryRow(ctx, `
+		INSERT INTO booking_transfers (
+			id,
+			booking_id,
+			booking_type,
+			from_user_id,
+			to_user_id,
+			fee_paid,
+			remaining_visits,
+			valid_until,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`,
+		transfer.Id,
+		transfer.BookingId,
+		transfer.BookingType,
+		transfer.FromUserId,
+		transfer.ToUserId,
+		transfer.FeePaid,
+		transfer.RemainingVisits,
+		validUntil,
+	).Scan(&createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("error recording booking transfer: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	transfer.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return &transfer, nil
+}
+
+// ListBookingTransfers retrieves transfers involving a user on either side,
+// or transfers of one booking.
+func (r *BookingTransferRepo) ListBookingTransfers(ctx context.Context, req *booking.ListBookingTransfersRequest) (*booking.ListBookingTransfersResponse, error) {
+	ctx, span := tracing.Start(ctx, "BookingTransferRepo.ListBookingTransfers")
+	defer span.End()
+
+	var args []interface{}
+	count := 1
+	query := `
+		SELECT
+			id,
+			booking_id,
+			booking_type,
+			from_user_id,
+			to_user_id,
+			fee_paid,
+			COALESCE(remaining_visits, 0),
+			valid_until,
+			created_at
+		FROM booking_transfers
+		WHERE 1=1
+	`
+
+	if req.UserId != "" {
+		query += fmt.Sprintf(" AND (from_user_id = $%d OR to_user_id = $%d)", count, count)
+		args = append(args, req.UserId)
+		count++
+	}
+	if req.BookingId != "" {
+		query += fmt.Sprintf(" AND booking_id = $%d", count)
+		args = append(args, req.BookingId)
+		count++
+	}
+	query += " ORDER BY created_at"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var transfers []*booking.BookingTransfer
+
+	for rows.Next() {
+		var (
+			transfer   booking.BookingTransfer
+			validUntil sql.NullTime
+			createdAt  time.Time
+		)
+
+		err := rows.Scan(
+			&transfer.Id,
+			&transfer.BookingId,
+			&transfer.BookingType,
+			&transfer.FromUserId,
+			&transfer.ToUserId,
+			&transfer.FeePaid,
+			&transfer.RemainingVisits,
+			&validUntil,
+			&createdAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		transfer.ValidUntil = helper.DateToString(validUntil)
+		transfer.CreatedAt = createdAt.Format(time.RFC3339)
+
+		transfers = append(transfers, &transfer)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return &booking.ListBookingTransfersResponse{BookingTransfers: transfers}, nil
+}
+
+// getTransferRule loads the transfer rule of a plan, defaulting to a
+// non-transferable rule when none is set.
+func getTransferRule(ctx context.Context, q querier, subscriptionType, subscriptionID string) (*booking.TransferRule, error) {
+	rule := booking.TransferRule{
+		SubscriptionId:   subscriptionID,
+		SubscriptionType: subscriptionType,
+	}
+
+	var updatedAt time.Time
+	err := q.QueryRow(ctx, `
+		SELECT transferable, fee, max_transfers, updated_at
+		FROM subscription_transfer_rules
+		WHERE subscription_type = $1 AND subscription_id = $2
+	`, subscriptionType, subscriptionID).Scan(
+		&rule.Transferable,
+		&rule.Fee,
+		&rule.MaxTransfers,
+		&updatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &rule, nil
+		}
+		return nil, fmt.Errorf("error getting transfer rule: %w", err)
+	}
+
+	rule.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return &rule, nil
+}
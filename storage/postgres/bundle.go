@@ -0,0 +1,597 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BundleRepo implements the BundleRepoI interface for subscription bundles.
+type BundleRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewBundleRepo creates a new BundleRepo.
+func NewBundleRepo(db *pgxpool.Pool, logger *slog.Logger) *BundleRepo {
+	return &BundleRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateBundle creates a bundle of plans sold at one price. Every plan must
+// belong to the bundle's gym.
+func (r *BundleRepo) CreateBundle(ctx context.Context, req *booking.CreateBundleRequest) (*booking.Bundle, error) {
+	ctx, span := tracing.Start(ctx, "BundleRepo.CreateBundle")
+	defer span.End()
+
+	bundle := req.Bundle
+	if len(bundle.Items) == 0 {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "bundle must include at least one plan")
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. Check every plan exists at the bundle's gym
+	for _, item := range bundle.Items {
+		if _, err := lookupBookingTable(item.SubscriptionType); err != nil {
+			return nil, err
+		}
+
+		var gymID string
+		query := fmt.Sprintf(`SELECT gym_id FROM subscription_%s WHERE id = $1`, item.SubscriptionType)
+		if err := tx.QueryRow(ctx, query, item.SubscriptionId).Scan(&gymID); err != nil {
+			return nil, fmt.Errorf("error getting %s plan %s: %w", item.SubscriptionType, item.SubscriptionId, err)
+		}
+		if gymID != bundle.GymId {
+			return nil, storage.Errorf(storage.ErrInvalidArgument, "%s plan %s belongs to another gym", item.SubscriptionType, item.SubscriptionId)
+		}
+	}
+
+	// 2. Create the bundle and its items
+	bundle.Id = uuid.New().String()
+	query := `
+		INSERT INTO bundles (
+			id,
+			gym_id,
+			name,
+			description,
+			price,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	var (
+		createdAt time.Time
+		updatedAt time.Time
+	)
+
+	err = tx.QueryRow(ctx, query,
+		bundle.Id,
+		bundle.GymId,
+		bundle.Name,
+		bundle.Description,
+		bundle.Price,
+	).Scan(&createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range bundle.Items {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO bundle_items (bundle_id, position, subscription_type, subscription_id)
+			VALUES ($1, $2, $3, $4)
+		`, bundle.Id, i, item.SubscriptionType, item.SubscriptionId)
+		if err != nil {
+			return nil, fmt.Errorf("error adding bundle item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	bundle.CreatedAt = createdAt.Format(time.RFC3339)
+	bundle.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return bundle, nil
+}
+
+// GetBundle retrieves a bundle with its plans by ID.
+func (r *BundleRepo) GetBundle(ctx context.Context, req *booking.GetBundleRequest) (*booking.Bundle, error) {
+	ctx, span := tracing.Start(ctx, "BundleRepo.GetBundle")
+	defer span.End()
+
+	query := `
+		SELECT id, gym_id, name, COALESCE(description, ''), price, created_at, updated_at
+		FROM bundles
+		WHERE id = $1 AND deleted_at = 0
+	`
+
+	var (
+		bundle    booking.Bundle
+		createdAt time.Time
+		updatedAt time.Time
+	)
+
+	err := r.db.QueryRow(ctx, query, req.Id).Scan(
+		&bundle.Id,
+		&bundle.GymId,
+		&bundle.Name,
+		&bundle.Description,
+		&bundle.Price,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.CreatedAt = createdAt.Format(time.RFC3339)
+	bundle.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	bundle.Items, err = listBundleItems(ctx, r.db, bundle.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+// DeleteBundle takes a bundle off sale. Bundles already bought keep their
+// bookings, so the row is only marked as deleted.
+func (r *BundleRepo) DeleteBundle(ctx context.Context, req *booking.DeleteBundleRequest) error {
+	ctx, span := tracing.Start(ctx, "BundleRepo.DeleteBundle")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE bundles
+		SET deleted_at = EXTRACT(EPOCH FROM NOW())::BIGINT, updated_at = NOW()
+		WHERE id = $1 AND deleted_at = 0
+	`
+
+	result, err := tx.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListBundles retrieves the bundles on sale, optionally filtered by gym.
+func (r *BundleRepo) ListBundles(ctx context.Context, req *booking.ListBundlesRequest) (*booking.ListBundlesResponse, error) {
+	ctx, span := tracing.Start(ctx, "BundleRepo.ListBundles")
+	defer span.End()
+
+	var args []interface{}
+	count := 1
+	query := `
+		SELECT id, gym_id, name, COALESCE(description, ''), price, created_at, updated_at
+		FROM bundles
+		WHERE deleted_at = 0
+	`
+
+	if req.GymId != "" {
+		query += fmt.Sprintf(" AND gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+
+	query += " ORDER BY created_at"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var bundles []*booking.Bundle
+
+	for rows.Next() {
+		var (
+			bundle    booking.Bundle
+			createdAt time.Time
+			updatedAt time.Time
+		)
+
+		err := rows.Scan(
+			&bundle.Id,
+			&bundle.GymId,
+			&bundle.Name,
+			&bundle.Description,
+			&bundle.Price,
+			&createdAt,
+			&updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		bundle.CreatedAt = createdAt.Format(time.RFC3339)
+		bundle.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+		bundles = append(bundles, &bundle)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	rows.Close()
+
+	for _, bundle := range bundles {
+		bundle.Items, err = listBundleItems(ctx, r.db, bundle.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &booking.ListBundlesResponse{Bundles: bundles}, nil
+}
+
+// PurchaseBundle buys a bundle for a user. One booking is created per plan in
+// the bundle, all in one transaction, so either every booking exists or none
+// does. The payment is split across the bookings in proportion to the plans'
+// own prices, and the bookings are linked to the purchase so they can be
+// listed and checked as one unit.
+func (r *BundleRepo) PurchaseBundle(ctx context.Context, req *booking.PurchaseBundleRequest) (*booking.BundlePurchase, error) {
+	ctx, span := tracing.Start(ctx, "BundleRepo.PurchaseBundle")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. Check the bundle is on sale and paid for
+	var price int32
+	err = tx.QueryRow(ctx, `
+		SELECT price FROM bundles WHERE id = $1 AND deleted_at = 0 FOR SHARE
+	`, req.BundleId).Scan(&price)
+	if err != nil {
+		return nil, fmt.Errorf("error getting bundle: %w", err)
+	}
+	if req.Payment < price {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "payment of %d does not cover the bundle price of %d", req.Payment, price)
+	}
+
+	items, err := listBundleItems(ctx, tx, req.BundleId)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "bundle has no plans")
+	}
+
+	// 2. Split the payment across the plans
+	shares, err := bundleShares(ctx, tx, items, req.Payment)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Record the purchase
+	purchase := booking.BundlePurchase{
+		Id:       uuid.New().String(),
+		BundleId: req.BundleId,
+		UserId:   req.UserId,
+		Payment:  req.Payment,
+	}
+
+	var (
+		startDate time.Time
+		createdAt time.Time
+	)
+	err = tx.QueryRow(ctx, `
+		INSERT INTO bundle_purchases (
+			id,
+			bundle_id,
+			user_id,
+			payment,
+			start_date,
+			created_at
+		) VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, '')::timestamp, NOW()), NOW())
+		RETURNING start_date, created_at
+	`, purchase.Id, purchase.BundleId, purchase.UserId, purchase.Payment, req.StartDate).Scan(&startDate, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("error recording bundle purchase: %w", err)
+	}
+
+	// 4. Create one booking per plan
+	for i, item := range items {
+		if err := checkSubscriptionGender(ctx, tx, item.SubscriptionType, item.SubscriptionId, req.UserId); err != nil {
+			return nil, err
+		}
+		if item.SubscriptionType == subscriptionTypeGroup {
+			if err := checkGroupCapacity(ctx, tx, item.SubscriptionId); err != nil {
+				return nil, err
+			}
+		}
+
+		tables, err := lookupBookingTable(item.SubscriptionType)
+		if err != nil {
+			return nil, err
+		}
+
+		bundleBooking := booking.BundleBooking{
+			BookingType:    item.SubscriptionType,
+			BookingId:      uuid.New().String(),
+			SubscriptionId: item.SubscriptionId,
+			Payment:        shares[i],
+		}
+
+		query := fmt.Sprintf(`
+			INSERT INTO %s (
+				id,
+				user_id,
+				subscription_id,
+				payment,
+				start_date,
+				count,
+				subscription_version,
+				bundle_purchase_id,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, 0, (SELECT version FROM subscription_%s WHERE id = $3), $6, NOW(), NOW())
+			RETURNING access_status
+		`, tables.booking, item.SubscriptionType)
+
+		err = tx.QueryRow(ctx, query,
+			bundleBooking.BookingId,
+			req.UserId,
+			bundleBooking.SubscriptionId,
+			bundleBooking.Payment,
+			startDate,
+			purchase.Id,
+		).Scan(&bundleBooking.AccessStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s booking: %w", item.SubscriptionType, err)
+		}
+		if err := enqueueBookingEvent(ctx, tx, events.TypeBookingCreated, item.SubscriptionType, bundleBooking.BookingId); err != nil {
+			return nil, err
+		}
+
+		purchase.Bookings = append(purchase.Bookings, &bundleBooking)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	for _, bundleBooking := range purchase.Bookings {
+		metrics.BookingsCreated.WithLabelValues(bundleBooking.BookingType).Inc()
+	}
+
+	purchase.StartDate = startDate.Format(time.RFC3339)
+	purchase.CreatedAt = createdAt.Format(time.RFC3339)
+
+	return &purchase, nil
+}
+
+// GetBundlePurchase retrieves a bundle purchase with its bookings.
+func (r *BundleRepo) GetBundlePurchase(ctx context.Context, req *booking.GetBundlePurchaseRequest) (*booking.BundlePurchase, error) {
+	ctx, span := tracing.Start(ctx, "BundleRepo.GetBundlePurchase")
+	defer span.End()
+
+	query := `
+		SELECT id, bundle_id, user_id, payment, start_date, created_at
+		FROM bundle_purchases
+		WHERE id = $1
+	`
+
+	var (
+		purchase  booking.BundlePurchase
+		startDate time.Time
+		createdAt time.Time
+	)
+
+	err := r.db.QueryRow(ctx, query, req.Id).Scan(
+		&purchase.Id,
+		&purchase.BundleId,
+		&purchase.UserId,
+		&purchase.Payment,
+		&startDate,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	purchase.StartDate = startDate.Format(time.RFC3339)
+	purchase.CreatedAt = createdAt.Format(time.RFC3339)
+
+	purchase.Bookings, err = listBundleBookings(ctx, r.db, purchase.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &purchase, nil
+}
+
+// ListBundlePurchases retrieves bundle purchases with their bookings,
+// optionally filtered by user or bundle.
+func (r *BundleRepo) ListBundlePurchases(ctx context.Context, req *booking.ListBundlePurchasesRequest) (*booking.ListBundlePurchasesResponse, error) {
+	ctx, span := tracing.Start(ctx, "BundleRepo.ListBundlePurchases")
+	defer span.End()
+
+	var args []interface{}
+	count := 1
+	query := `
+		SELECT id, bundle_id, user_id, payment, start_date, created_at
+		FROM bundle_purchases
+		WHERE 1=1
+	`
+
+	if req.UserId != "" {
+		query += fmt.Sprintf(" AND user_id = $%d", count)
+		args = append(args, req.UserId)
+		count++
+	}
+
+	if req.BundleId != "" {
+		query += fmt.Sprintf(" AND bundle_id = $%d", count)
+		args = append(args, req.BundleId)
+		count++
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var purchases []*booking.BundlePurchase
+
+	for rows.Next() {
+		var (
+			purchase  booking.BundlePurchase
+			startDate time.Time
+			createdAt time.Time
+		)
+
+		err := rows.Scan(
+			&purchase.Id,
+			&purchase.BundleId,
+			&purchase.UserId,
+			&purchase.Payment,
+			&startDate,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		purchase.StartDate = startDate.Format(time.RFC3339)
+		purchase.CreatedAt = createdAt.Format(time.RFC3339)
+
+		purchases = append(purchases, &purchase)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	rows.Close()
+
+	for _, purchase := range purchases {
+		purchase.Bookings, err = listBundleBookings(ctx, r.db, purchase.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &booking.ListBundlePurchasesResponse{BundlePurchases: purchases}, nil
+}
+
+// listBundleItems returns the plans of a bundle in the order they were added.
+func listBundleItems(ctx context.Context, q querier, bundleID string) ([]*booking.BundleItem, error) {
+	rows, err := q.Query(ctx, `
+		SELECT subscription_type, subscription_id
+		FROM bundle_items
+		WHERE bundle_id = $1
+		ORDER BY position
+	`, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing bundle items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*booking.BundleItem
+	for rows.Next() {
+		var item booking.BundleItem
+		if err := rows.Scan(&item.SubscriptionType, &item.SubscriptionId); err != nil {
+			return nil, fmt.Errorf("error scanning bundle item: %w", err)
+		}
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+// listBundleBookings returns the bookings created by a bundle purchase.
+func listBundleBookings(ctx context.Context, q querier, purchaseID string) ([]*booking.BundleBooking, error) {
+	rows, err := q.Query(ctx, `
+		SELECT 'personal', id, subscription_id, payment, access_status FROM booking_personal WHERE bundle_purchase_id = $1
+		UNION ALL
+		SELECT 'group', id, subscription_id, payment, access_status FROM booking_group WHERE bundle_purchase_id = $1
+		UNION ALL
+		SELECT 'coach', id, subscription_id, payment, access_status FROM booking_coach WHERE bundle_purchase_id = $1
+	`, purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing bundle bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []*booking.BundleBooking
+	for rows.Next() {
+		var b booking.BundleBooking
+		err := rows.Scan(&b.BookingType, &b.BookingId, &b.SubscriptionId, &b.Payment, &b.AccessStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning bundle booking: %w", err)
+		}
+		bookings = append(bookings, &b)
+	}
+
+	return bookings, rows.Err()
+}
+
+// bundleShares splits payment across the bundle's plans in proportion to
+// their own prices. Rounding leftovers go to the first plan so the shares
+// always add up to the payment.
+func bundleShares(ctx context.Context, q querier, items []*booking.BundleItem, payment int32) ([]int32, error) {
+	prices := make([]int64, len(items))
+	var total int64
+	for i, item := range items {
+		var price int32
+		query := fmt.Sprintf(`SELECT COALESCE(price, 0) FROM subscription_%s WHERE id = $1`, item.SubscriptionType)
+		if err := q.QueryRow(ctx, query, item.SubscriptionId).Scan(&price); err != nil {
+			return nil, fmt.Errorf("error getting %s plan price: %w", item.SubscriptionType, err)
+		}
+		prices[i] = int64(price)
+		total += int64(price)
+	}
+
+	shares := make([]int32, len(items))
+	var allocated int32
+	for i := range items {
+		if total > 0 {
+			shares[i] = int32(int64(payment) * prices[i] / total)
+		} else {
+			shares[i] = payment / int32(len(items))
+		}
+		allocated += shares[i]
+	}
+	shares[0] += payment - allocated
+
+	return shares, nil
+}
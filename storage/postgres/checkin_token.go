@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+)
+
+// IssueCheckInToken returns a rotating check-in token for a sport hall to the
+// calling member. The token is only ever issued to the caller, so knowing
+// someone's user ID is not enough to get in as them.
+func (r *AccessBetaRepo) IssueCheckInToken(ctx context.Context, req *booking.IssueCheckInTokenRequest) (*booking.CheckInToken, error) {
+	ctx, span := tracing.Start(ctx, "AccessBetaRepo.IssueCheckInToken")
+	defer span.End()
+
+	if r.tokens == nil {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "check-in tokens are not configured")
+	}
+	userID := audit.FromContext(ctx).Actor
+	if userID == "" {
+		return nil, storage.Errorf(storage.ErrUnauthenticated, "check-in tokens are issued to the calling member")
+	}
+	if req.SportHallId == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "sport_hall_id is required")
+	}
+
+	token, expiresAt := r.tokens.Issue(userID, req.SportHallId, time.Now())
+
+	return &booking.CheckInToken{
+		Token:     token,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// CheckInWithToken verifies a scanned check-in token, marks it used and then
+// runs the normal access check for the member it was issued to.
+func (r *AccessBetaRepo) CheckInWithToken(ctx context.Context, req *booking.CheckInWithTokenRequest) (*booking.AccessBetaPersonalResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessBetaRepo.CheckInWithToken")
+	defer span.End()
+
+	if r.tokens == nil {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "check-in tokens are not configured")
+	}
+
+	claims, err := r.tokens.Verify(req.Token, time.Now())
+	if err != nil || claims.GymID != req.SportHallId {
+		denial := accessEvent{
+			gymID:          req.SportHallId,
+			deviceID:       req.DeviceId,
+			credentialType: credentialToken,
+			reason:         denyReasonInvalidToken,
+		}
+		if err == nil {
+			denial.userID = claims.UserID
+		}
+		if err := r.recordDenial(ctx, denial); err != nil {
+			return nil, err
+		}
+		return &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonInvalidToken}, nil
+	}
+
+	used, err := useCheckInToken(ctx, r.db, req.Token, claims.UserID, claims.GymID, claims.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if used {
+		err = r.recordDenial(ctx, accessEvent{
+			gymID:          req.SportHallId,
+			userID:         claims.UserID,
+			deviceID:       req.DeviceId,
+			credentialType: credentialToken,
+			reason:         denyReasonTokenUsed,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonTokenUsed}, nil
+	}
+
+	return r.checkAccess(ctx, &booking.AccessBetaPersonalRequest{
+		UserId:      claims.UserID,
+		SportHallId: claims.GymID,
+		DeviceId:    req.DeviceId,
+	}, credentialToken)
+}
+
+// useCheckInToken records a token as used and reports whether it already was.
+// Expired tokens can no longer pass verification, so their rows are pruned.
+func useCheckInToken(ctx context.Context, q querier, token, userID, gymID string, expiresAt time.Time) (bool, error) {
+	if _, err := q.Exec(ctx, `DELETE FROM checkin_tokens_used WHERE expires_at < NOW()`); err != nil {
+		return false, fmt.Errorf("error pruning check-in tokens: %w", err)
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	result, err := q.Exec(ctx, `
+		INSERT INTO checkin_tokens_used (token_hash, user_id, gym_id, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (token_hash) DO NOTHING
+	`, hash[:], userID, gymID, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("error using check-in token: %w", err)
+	}
+
+	return result.RowsAffected() == 0, nil
+}
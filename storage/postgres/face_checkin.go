@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FaceResolver implements storage.FaceResolver by looking up users.face_id.
+type FaceResolver struct {
+	db *pgxpool.Pool
+}
+
+// NewFaceResolver creates a new FaceResolver.
+func NewFaceResolver(db *pgxpool.Pool) *FaceResolver {
+	return &FaceResolver{
+		db: db,
+	}
+}
+
+// ResolveFace returns the active user registered with the face identifier.
+func (r *FaceResolver) ResolveFace(ctx context.Context, faceID string) (string, error) {
+	ctx, span := tracing.Start(ctx, "FaceResolver.ResolveFace")
+	defer span.End()
+
+	var userID string
+	err := r.db.QueryRow(ctx, `
+		SELECT id FROM users WHERE face_id = $1 AND deleted_at = 0
+	`, faceID).Scan(&userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("error resolving face: %w", err)
+	}
+	return userID, nil
+}
+
+// SetFaceResolver replaces the resolver used by CheckInWithFace, e.g. with
+// one backed by the recognition vendor's own user directory.
+func (r *AccessBetaRepo) SetFaceResolver(faces storage.FaceResolver) {
+	r.faces = faces
+}
+
+// CheckInWithFace resolves a face matched by a recognition device to a member
+// and runs the normal access check for them. Every attempt is logged with the
+// device that made it.
+func (r *AccessBetaRepo) CheckInWithFace(ctx context.Context, req *booking.FaceCheckInRequest) (*booking.AccessBetaPersonalResponse, error) {
+	ctx, span := tracing.Start(ctx, "AccessBetaRepo.CheckInWithFace")
+	defer span.End()
+
+	if req.FaceId == "" || req.DeviceId == "" || req.SportHallId == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "face_id, device_id and sport_hall_id are required")
+	}
+
+	var (
+		userID string
+		resp   *booking.AccessBetaPersonalResponse
+		err    error
+	)
+
+	if float64(req.Confidence) < r.faceMinConfidence {
+		resp = &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonLowConfidence}
+	} else {
+		userID, err = r.faces.ResolveFace(ctx, req.FaceId)
+		if err != nil {
+			return nil, err
+		}
+		if userID == "" {
+			resp = &booking.AccessBetaPersonalResponse{Message: "denied", Reason: denyReasonUnknownFace}
+		} else {
+			resp, err = r.checkAccess(ctx, &booking.AccessBetaPersonalRequest{
+				UserId:      userID,
+				SportHallId: req.SportHallId,
+				DeviceId:    req.DeviceId,
+			}, credentialFace)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Denials decided before the access check are added to the access log here
+	if userID == "" {
+		err = r.recordDenial(ctx, accessEvent{
+			gymID:          req.SportHallId,
+			deviceID:       req.DeviceId,
+			credentialType: credentialFace,
+			reason:         resp.Reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		INSERT INTO face_checkin_attempts (
+			gym_id,
+			device_id,
+			face_id,
+			confidence,
+			user_id,
+			result,
+			reason
+		) VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, NULLIF($7, ''))
+	`
+	_, err = r.db.Exec(ctx, query,
+		req.SportHallId,
+		req.DeviceId,
+		req.FaceId,
+		req.Confidence,
+		userID,
+		resp.Message,
+		resp.Reason,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error logging face check-in attempt: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/helper"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// GenderOverrideRepo implements the GenderOverrideRepoI interface for
+// exceptions to gender-restricted halls.
+type GenderOverrideRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewGenderOverrideRepo creates a new GenderOverrideRepo.
+func NewGenderOverrideRepo(db *pgxpool.Pool, logger *slog.Logger) *GenderOverrideRepo {
+	return &GenderOverrideRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const genderOverrideColumns = `id, gym_id, user_id, granted_by, reason, created_at, revoked_at, COALESCE(revoked_by::text, '')`
+
+// GrantGenderOverride lets a member use a gender-restricted hall. The staff
+// member granting it and the reason are required, so every exception can be
+// traced. A member has at most one active override per hall.
+func (r *GenderOverrideRepo) GrantGenderOverride(ctx context.Context, req *booking.GrantGenderOverrideRequest) (*booking.GenderOverride, error) {
+	ctx, span := tracing.Start(ctx, "GenderOverrideRepo.GrantGenderOverride")
+	defer span.End()
+
+	override := req.GenderOverride
+	if override.GrantedBy == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "override must record the staff member granting it")
+	}
+	if override.Reason == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "override must have a reason")
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := fmt.Sprintf(`
+		INSERT INTO gender_overrides (
+			id,
+			gym_id,
+			user_id,
+			granted_by,
+			reason,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING %s
+	`, genderOverrideColumns)
+
+	created, err := scanGenderOverride(tx.QueryRow(ctx, query,
+		uuid.New().String(),
+		override.GymId,
+		override.UserId,
+		override.GrantedBy,
+		override.Reason,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// RevokeGenderOverride ends an active override and records who revoked it.
+func (r *GenderOverrideRepo) RevokeGenderOverride(ctx context.Context, req *booking.RevokeGenderOverrideRequest) error {
+	ctx, span := tracing.Start(ctx, "GenderOverrideRepo.RevokeGenderOverride")
+	defer span.End()
+
+	if req.RevokedBy == "" {
+		return storage.Errorf(storage.ErrInvalidArgument, "revocation must record the staff member revoking it")
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE gender_overrides
+		SET revoked_at = NOW(), revoked_by = $2
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := tx.Exec(ctx, query, req.Id, req.RevokedBy)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListGenderOverrides retrieves overrides by hall or member. Revoked
+// overrides are part of the audit trail and are listed on request.
+func (r *GenderOverrideRepo) ListGenderOverrides(ctx context.Context, req *booking.ListGenderOverridesRequest) (*booking.ListGenderOverridesResponse, error) {
+	ctx, span := tracing.Start(ctx, "GenderOverrideRepo.ListGenderOverrides")
+	defer span.End()
+
+	var args []interface{}
+	count := 1
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM gender_overrides
+		WHERE 1=1
+	`, genderOverrideColumns)
+
+	if req.GymId != "" {
+		query += fmt.Sprintf(" AND gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+
+	if req.UserId != "" {
+		query += fmt.Sprintf(" AND user_id = $%d", count)
+		args = append(args, req.UserId)
+		count++
+	}
+
+	if !req.IncludeRevoked {
+		query += " AND revoked_at IS NULL"
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []*booking.GenderOverride
+
+	for rows.Next() {
+		override, err := scanGenderOverride(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		overrides = append(overrides, override)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return &booking.ListGenderOverridesResponse{GenderOverrides: overrides}, nil
+}
+
+// SetMemberGender records a member's gender, as checked by staff, so hall
+// gender rules apply to them. The staff member recording it is required.
+func (r *GenderOverrideRepo) SetMemberGender(ctx context.Context, req *booking.SetMemberGenderRequest) (*booking.MemberGender, error) {
+	ctx, span := tracing.Start(ctx, "GenderOverrideRepo.SetMemberGender")
+	defer span.End()
+
+	if req.SetBy == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "gender must record the staff member setting it")
+	}
+	if req.Gender != "male" && req.Gender != "female" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "invalid gender %q", req.Gender)
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE users
+		SET gender = $2, gender_set_by = $3, gender_set_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, gender::text, gender_set_by, gender_set_at
+	`
+
+	var (
+		gender booking.MemberGender
+		setAt  time.Time
+	)
+	err = tx.QueryRow(ctx, query, req.UserId, req.Gender, req.SetBy).Scan(
+		&gender.UserId,
+		&gender.Gender,
+		&gender.SetBy,
+		&setAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	gender.SetAt = setAt.Format(time.RFC3339)
+
+	return &gender, nil
+}
+
+// scanGenderOverride scans a row selected with genderOverrideColumns.
+func scanGenderOverride(row pgx.Row) (*booking.GenderOverride, error) {
+	var (
+		override  booking.GenderOverride
+		createdAt time.Time
+		revokedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&override.Id,
+		&override.GymId,
+		&override.UserId,
+		&override.GrantedBy,
+		&override.Reason,
+		&createdAt,
+		&revokedAt,
+		&override.RevokedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	override.CreatedAt = createdAt.Format(time.RFC3339)
+	override.RevokedAt = helper.DateToString(revokedAt)
+
+	return &override, nil
+}
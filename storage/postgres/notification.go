@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/notify"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// memberSettingsJoin joins a member's most recent settings as st, for
+// reminders about bookings aliased b.
+const memberSettingsJoin = `
+	LEFT JOIN LATERAL (
+		SELECT notification, language
+		FROM settings
+		WHERE user_id = b.user_id
+		ORDER BY updated_at DESC NULLS LAST
+		LIMIT 1
+	) st ON TRUE
+`
+
+// NotificationRepo implements notify.Store.
+type NotificationRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewNotificationRepo creates a new NotificationRepo.
+func NewNotificationRepo(db *pgxpool.Pool, logger *slog.Logger) *NotificationRepo {
+	return &NotificationRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// ScheduleReminders implements notify.Store.
+func (r *NotificationRepo) ScheduleReminders(ctx context.Context, rules notify.Rules) (int, error) {
+	ctx, span := tracing.Start(ctx, "NotificationRepo.ScheduleReminders")
+	defer span.End()
+
+	total := 0
+
+	// 1. Personal plans ending within the notice period
+	result, err := r.db.Exec(ctx, `
+		INSERT INTO notifications (id, user_id, kind, dedupe_key, language, booking_id, gym_id, occurs_at)
+		SELECT gen_random_uuid(), b.user_id, $1, b.id::text, COALESCE(st.language, 'en'), b.id, sp.gym_id, e.ends_at
+		FROM booking_personal b
+		JOIN subscription_personal sp ON sp.id = b.subscription_id
+		JOIN subscription_versions v ON v.subscription_type = 'personal'
+			AND v.subscription_id = b.subscription_id
+			AND v.version = b.subscription_version
+		CROSS JOIN LATERAL (SELECT b.start_date + v.duration * INTERVAL '1 day' AS ends_at) e
+		`+memberSettingsJoin+`
+		WHERE b.access_status = 'granted' AND b.closed_at IS NULL AND b.user_id IS NOT NULL
+			AND e.ends_at > NOW() AND e.ends_at <= NOW() + make_interval(secs => $2)
+			AND COALESCE(st.notification, 'on') <> 'off'
+		ON CONFLICT (kind, dedupe_key) DO NOTHING
+	`, notify.KindPlanExpiring, rules.ExpiryNotice.Seconds())
+	if err != nil {
+		return total, fmt.Errorf("error scheduling plan expiry reminders: %w", err)
+	}
+	total += int(result.RowsAffected())
+
+	// 2. Running personal plans with few visits left
+	result, err = r.db.Exec(ctx, `
+		INSERT INTO notifications (id, user_id, kind, dedupe_key, language, booking_id, gym_id, visits_left)
+		SELECT gen_random_uuid(), b.user_id, $1, b.id::text, COALESCE(st.language, 'en'), b.id, sp.gym_id, l.visits_left
+		FROM booking_personal b
+		JOIN subscription_personal sp ON sp.id = b.subscription_id
+		JOIN subscription_versions v ON v.subscription_type = 'personal'
+			AND v.subscription_id = b.subscription_id
+			AND v.version = b.subscription_version
+		CROSS JOIN LATERAL (
+			SELECT v.count - (SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = b.id) AS visits_left
+		) l
+		`+memberSettingsJoin+`
+		WHERE b.access_status = 'granted' AND b.closed_at IS NULL AND b.user_id IS NOT NULL AND b.count <> -1
+			AND b.start_date + v.duration * INTERVAL '1 day' > NOW()
+			AND l.visits_left > 0 AND l.visits_left <= $2
+			AND COALESCE(st.notification, 'on') <> 'off'
+		ON CONFLICT (kind, dedupe_key) DO NOTHING
+	`, notify.KindVisitsLeft, rules.VisitsLeft)
+	if err != nil {
+		return total, fmt.Errorf("error scheduling visits left reminders: %w", err)
+	}
+	total += int(result.RowsAffected())
+
+	// 3. Group classes starting within the notice period, once per class time
+	result, err = r.db.Exec(ctx, `
+		INSERT INTO notifications (id, user_id, kind, dedupe_key, language, booking_id, gym_id, occurs_at)
+		SELECT gen_random_uuid(), b.user_id, $1, b.id::text || '@' || sg.time::text, COALESCE(st.language, 'en'), b.id, sg.gym_id, sg.time
+		FROM booking_group b
+		JOIN subscription_group sg ON sg.id = b.subscription_id
+		`+memberSettingsJoin+`
+		WHERE b.access_status = 'granted' AND b.user_id IS NOT NULL
+			AND sg.time > NOW() AND sg.time <= NOW() + make_interval(secs => $2)
+			AND COALESCE(st.notification, 'on') <> 'off'
+		ON CONFLICT (kind, dedupe_key) DO NOTHING
+	`, notify.KindClassStarting, rules.ClassNotice.Seconds())
+	if err != nil {
+		return total, fmt.Errorf("error scheduling class reminders: %w", err)
+	}
+	total += int(result.RowsAffected())
+
+	return total, nil
+}
+
+// ClaimReminders implements notify.Store.
+func (r *NotificationRepo) ClaimReminders(ctx context.Context, limit int, lease time.Duration) ([]*notify.Reminder, error) {
+	ctx, span := tracing.Start(ctx, "NotificationRepo.ClaimReminders")
+	defer span.End()
+
+	rows, err := r.db.Query(ctx, `
+		UPDATE notifications n
+		SET send_after = NOW() + make_interval(secs => $2)
+		FROM sport_halls sh
+		WHERE sh.id = n.gym_id AND n.id IN (
+			SELECT id
+			FROM notifications
+			WHERE status = 'pending' AND send_after <= NOW()
+			ORDER BY send_after
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			n.id,
+			n.user_id,
+			n.kind,
+			n.language,
+			COALESCE(n.booking_id::text, ''),
+			COALESCE(n.occurs_at, NOW()),
+			sh.timezone,
+			COALESCE(n.visits_left, 0),
+			n.attempts
+	`, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("error claiming reminders: %w", err)
+	}
+	defer rows.Close()
+
+	var reminders []*notify.Reminder
+	for rows.Next() {
+		var reminder notify.Reminder
+		err := rows.Scan(
+			&reminder.ID,
+			&reminder.UserID,
+			&reminder.Kind,
+			&reminder.Language,
+			&reminder.BookingID,
+			&reminder.OccursAt,
+			&reminder.Timezone,
+			&reminder.VisitsLeft,
+			&reminder.Attempts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error claiming reminders: %w", err)
+		}
+		reminders = append(reminders, &reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error claiming reminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// RecordOutcome implements notify.Store.
+func (r *NotificationRepo) RecordOutcome(ctx context.Context, id string, outcome notify.Outcome) error {
+	ctx, span := tracing.Start(ctx, "NotificationRepo.RecordOutcome")
+	defer span.End()
+
+	status := "pending"
+	switch {
+	case outcome.Error == "":
+		status = "sent"
+	case outcome.RetryAt.IsZero():
+		status = "failed"
+	}
+
+	var retryAt any
+	if !outcome.RetryAt.IsZero() {
+		retryAt = outcome.RetryAt
+	}
+
+	_, err := r.db.Exec(ctx, `
+		UPDATE notifications
+		SET
+			attempts = attempts + 1,
+			status = $2,
+			last_error = NULLIF($3, ''),
+			send_after = COALESCE($4::timestamptz, send_after),
+			sent_at = CASE WHEN $2 = 'sent' THEN NOW() END
+		WHERE id = $1
+	`, id, status, outcome.Error, retryAt)
+	if err != nil {
+		return fmt.Errorf("error recording reminder outcome: %w", err)
+	}
+	return nil
+}
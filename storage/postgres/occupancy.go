@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OccupancyRepo implements the OccupancyRepoI interface for live hall occupancy.
+type OccupancyRepo struct {
+	db             *pgxpool.Pool
+	reentryTimeout time.Duration
+	broker         *pubsub.Broker[*booking.Occupancy]
+	logger         *slog.Logger
+}
+
+// NewOccupancyRepo creates a new OccupancyRepo. Changes are published on
+// broker by every path that moves members in or out of a hall.
+func NewOccupancyRepo(db *pgxpool.Pool, cfg config.Config, broker *pubsub.Broker[*booking.Occupancy], logger *slog.Logger) *OccupancyRepo {
+	return &OccupancyRepo{
+		db:             db,
+		reentryTimeout: cfg.AccessReentryTimeout,
+		broker:         broker,
+		logger:         logger,
+	}
+}
+
+// GetOccupancy returns the number of members inside a hall right now.
+func (r *OccupancyRepo) GetOccupancy(ctx context.Context, req *booking.GetOccupancyRequest) (*booking.Occupancy, error) {
+	ctx, span := tracing.Start(ctx, "OccupancyRepo.GetOccupancy")
+	defer span.End()
+
+	return getOccupancy(ctx, r.db, req.GymId, r.reentryTimeout)
+}
+
+// SetMaxOccupancy sets the most members a hall may hold at once. Access
+// checks deny entry while the hall is full.
+func (r *OccupancyRepo) SetMaxOccupancy(ctx context.Context, req *booking.SetMaxOccupancyRequest) (*booking.Occupancy, error) {
+	ctx, span := tracing.Start(ctx, "OccupancyRepo.SetMaxOccupancy")
+	defer span.End()
+
+	if req.MaxOccupancy < 0 {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "invalid max occupancy %d", req.MaxOccupancy)
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE sport_halls
+		SET max_occupancy = NULLIF($2, 0), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := tx.Exec(ctx, query, req.GymId, req.MaxOccupancy)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	occupancy, err := getOccupancy(ctx, tx, req.GymId, r.reentryTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	r.broker.Publish(occupancy)
+
+	return occupancy, nil
+}
+
+// WatchOccupancy returns a channel receiving every occupancy change of a
+// hall until ctx is done. The channel is also closed if the watcher falls
+// too far behind, in which case the caller should fetch the occupancy again.
+func (r *OccupancyRepo) WatchOccupancy(ctx context.Context, gymID string) <-chan *booking.Occupancy {
+	updates, cancel := r.broker.Subscribe()
+	out := make(chan *booking.Occupancy)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case occupancy, ok := <-updates:
+				if !ok {
+					return
+				}
+				if occupancy.GymId != gymID {
+					continue
+				}
+				select {
+				case out <- occupancy:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// getOccupancy counts the members whose last scan at the hall is an entry.
+// Entries older than the re-entry timeout are treated as members who left
+// without scanning out, so only scans inside the timeout are read. Scans are
+// ordered by when they happened, since staff can record visits after the fact.
+func getOccupancy(ctx context.Context, q querier, gymID string, reentryTimeout time.Duration) (*booking.Occupancy, error) {
+	occupancy := booking.Occupancy{GymId: gymID}
+
+	var updatedAt time.Time
+	err := q.QueryRow(ctx, `
+		SELECT
+			(
+				SELECT COUNT(*)
+				FROM (
+					SELECT DISTINCT ON (user_id) direction
+					FROM access_events
+					WHERE gym_id = $1 AND result = 'granted' AND created_at > NOW() - make_interval(secs => $2)
+					ORDER BY user_id, created_at DESC, id DESC
+				) last
+				WHERE last.direction = 'entry'
+			),
+			COALESCE(sh.max_occupancy, 0),
+			NOW()
+		FROM sport_halls sh
+		WHERE sh.id = $1
+	`, gymID, reentryTimeout.Seconds()).Scan(
+		&occupancy.Current,
+		&occupancy.MaxOccupancy,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting occupancy: %w", err)
+	}
+
+	occupancy.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return &occupancy, nil
+}
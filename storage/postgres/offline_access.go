@@ -0,0 +1,589 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/checkin"
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+)
+
+// offlineSnapshotRetention is how long superseded offline access versions are
+// kept for computing deltas. Older controllers must export a full snapshot.
+const offlineSnapshotRetention = 30 * 24 * time.Hour
+
+// OfflineAccessRepo implements the OfflineAccessRepoI interface for turnstile
+// controllers working without a connection to the service.
+type OfflineAccessRepo struct {
+	db     *pgxpool.Pool
+	secret []byte
+	events *pubsub.Broker[*booking.AccessEvent]
+	logger *slog.Logger
+}
+
+// NewOfflineAccessRepo creates a new OfflineAccessRepo. Imported scans are
+// published on events.
+func NewOfflineAccessRepo(db *pgxpool.Pool, cfg config.Config, events *pubsub.Broker[*booking.AccessEvent], logger *slog.Logger) *OfflineAccessRepo {
+	return &OfflineAccessRepo{
+		db:     db,
+		secret: []byte(cfg.OfflineExportSecret),
+		events: events,
+		logger: logger,
+	}
+}
+
+// ExportOfflineAccess returns the signed list of credentials currently valid
+// at a gym. A new version is stored whenever the list has changed since the
+// last export. With since_version set, only the changes since that version
+// are returned.
+func (r *OfflineAccessRepo) ExportOfflineAccess(ctx context.Context, req *booking.ExportOfflineAccessRequest) (*booking.OfflineAccessSnapshot, error) {
+	ctx, span := tracing.Start(ctx, "OfflineAccessRepo.ExportOfflineAccess")
+	defer span.End()
+
+	if len(r.secret) == 0 {
+		return nil, storage.Errorf(storage.ErrFailedPrecondition, "offline access export is not configured")
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. Lock the gym so concurrent exports agree on the version number
+	snapshot := booking.OfflineAccessSnapshot{
+		GymId:       req.GymId,
+		GeneratedAt: time.Now().Format(time.RFC3339),
+	}
+	err = tx.QueryRow(ctx, `SELECT timezone FROM sport_halls WHERE id = $1 FOR UPDATE`, req.GymId).Scan(&snapshot.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sport hall: %w", err)
+	}
+
+	// 2. Build the current credential list and store it if it changed
+	credentials, err := listOfflineCredentials(ctx, tx, req.GymId)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodeOfflineCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest []byte
+	err = tx.QueryRow(ctx, `
+		SELECT version, credentials
+		FROM offline_access_snapshots
+		WHERE gym_id = $1
+		ORDER BY version DESC
+		LIMIT 1
+	`, req.GymId).Scan(&snapshot.Version, &latest)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, fmt.Errorf("error getting latest offline access version: %w", err)
+	}
+
+	if snapshot.Version == 0 || !bytes.Equal(latest, encoded) {
+		snapshot.Version++
+		_, err = tx.Exec(ctx, `
+			INSERT INTO offline_access_snapshots (gym_id, version, credentials)
+			VALUES ($1, $2, $3)
+		`, req.GymId, snapshot.Version, encoded)
+		if err != nil {
+			return nil, fmt.Errorf("error storing offline access version: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			DELETE FROM offline_access_snapshots
+			WHERE gym_id = $1 AND version < $2 AND created_at < NOW() - make_interval(secs => $3)
+		`, req.GymId, snapshot.Version, offlineSnapshotRetention.Seconds())
+		if err != nil {
+			return nil, fmt.Errorf("error pruning offline access versions: %w", err)
+		}
+	}
+
+	// 3. Return everything, or only what changed since the controller's version
+	snapshot.Credentials = credentials
+	if req.SinceVersion > 0 {
+		var base []byte
+		err = tx.QueryRow(ctx, `
+			SELECT credentials FROM offline_access_snapshots WHERE gym_id = $1 AND version = $2
+		`, req.GymId, req.SinceVersion).Scan(&base)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, storage.Errorf(storage.ErrFailedPrecondition, "offline access version %d not found, export a full snapshot", req.SinceVersion)
+			}
+			return nil, fmt.Errorf("error getting offline access version: %w", err)
+		}
+
+		var baseSnapshot booking.OfflineAccessSnapshot
+		if err := proto.Unmarshal(base, &baseSnapshot); err != nil {
+			return nil, fmt.Errorf("error decoding offline access version: %w", err)
+		}
+
+		snapshot.BaseVersion = req.SinceVersion
+		snapshot.Credentials, snapshot.Removed = diffOfflineCredentials(baseSnapshot.Credentials, credentials)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(&snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding offline access snapshot: %w", err)
+	}
+	snapshot.Signature = checkin.Sign(r.secret, payload)
+
+	return &snapshot, nil
+}
+
+// RegisterOfflineDevice allows a controller to upload offline scans for a gym
+// and generates the key it signs them with. Registering the device again
+// replaces its key. The key is only returned here.
+func (r *OfflineAccessRepo) RegisterOfflineDevice(ctx context.Context, req *booking.RegisterOfflineDeviceRequest) (*booking.OfflineDevice, error) {
+	ctx, span := tracing.Start(ctx, "OfflineAccessRepo.RegisterOfflineDevice")
+	defer span.End()
+
+	if req.GymId == "" || req.DeviceId == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "gym_id and device_id are required")
+	}
+
+	key, err := newOfflineDeviceKey()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	device := booking.OfflineDevice{
+		DeviceId: req.DeviceId,
+		GymId:    req.GymId,
+		Key:      key,
+	}
+	var createdAt time.Time
+	err = tx.QueryRow(ctx, `
+		INSERT INTO offline_devices (device_id, gym_id, key, created_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (device_id) DO UPDATE
+		SET key = EXCLUDED.key, created_at = EXCLUDED.created_at, revoked_at = NULL
+		WHERE offline_devices.gym_id = EXCLUDED.gym_id
+		RETURNING created_at
+	`, req.DeviceId, req.GymId, key).Scan(&createdAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, storage.Errorf(storage.ErrAlreadyExists, "device %s is registered to another gym", req.DeviceId)
+		}
+		return nil, fmt.Errorf("error registering offline device: %w", err)
+	}
+	device.CreatedAt = createdAt.Format(time.RFC3339)
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return &device, nil
+}
+
+// RevokeOfflineDevice stops a controller from uploading offline scans.
+func (r *OfflineAccessRepo) RevokeOfflineDevice(ctx context.Context, req *booking.RevokeOfflineDeviceRequest) error {
+	ctx, span := tracing.Start(ctx, "OfflineAccessRepo.RevokeOfflineDevice")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE offline_devices
+		SET revoked_at = NOW()
+		WHERE gym_id = $1 AND device_id = $2 AND revoked_at IS NULL
+	`, req.GymId, req.DeviceId)
+	if err != nil {
+		return fmt.Errorf("error revoking offline device: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ImportOfflineEntries records the scans a controller logged while offline.
+// The upload must be signed with the key of a device registered to the gym.
+// Entries are applied in scan order and each one is imported once per device,
+// so an upload can be retried. Entries that went over the booking's visits
+// are still recorded, since the member did go in, and are counted so staff
+// can follow up.
+func (r *OfflineAccessRepo) ImportOfflineEntries(ctx context.Context, req *booking.ImportOfflineEntriesRequest) (*booking.ImportOfflineEntriesResponse, error) {
+	ctx, span := tracing.Start(ctx, "OfflineAccessRepo.ImportOfflineEntries")
+	defer span.End()
+
+	if req.GymId == "" || req.DeviceId == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "gym_id and device_id are required")
+	}
+	if err := r.verifyOfflineUpload(ctx, req); err != nil {
+		return nil, err
+	}
+
+	entries := append([]*booking.OfflineEntry(nil), req.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ScannedAt < entries[j].ScannedAt
+	})
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		resp      booking.ImportOfflineEntriesResponse
+		published []*booking.AccessEvent
+	)
+
+	for _, entry := range entries {
+		// 1. Reject scans that do not belong to this gym
+		scannedAt, err := time.Parse(time.RFC3339, entry.ScannedAt)
+		if err != nil || entry.ClientEntryId == "" || entry.UserId == "" ||
+			(entry.Direction != accessDirectionEntry && entry.Direction != accessDirectionExit) ||
+			(entry.Direction == accessDirectionEntry && entry.BookingId == "") {
+			resp.Rejected++
+			continue
+		}
+
+		visitsLeft := int32(-1)
+		if entry.BookingId != "" {
+			var found bool
+			visitsLeft, found, err = personalVisitsLeft(ctx, tx, req.GymId, entry.BookingId, entry.UserId)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				resp.Rejected++
+				continue
+			}
+		}
+		overLimit := entry.Direction == accessDirectionEntry && visitsLeft == 0
+
+		// 2. Skip entries uploaded before
+		result, err := tx.Exec(ctx, `
+			INSERT INTO offline_entries (
+				device_id,
+				client_entry_id,
+				gym_id,
+				user_id,
+				booking_id,
+				direction,
+				scanned_at,
+				over_limit
+			) VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, $7, $8)
+			ON CONFLICT (device_id, client_entry_id) DO NOTHING
+		`, req.DeviceId, entry.ClientEntryId, req.GymId, entry.UserId, entry.BookingId, entry.Direction, entry.ScannedAt, overLimit)
+		if err != nil {
+			return nil, fmt.Errorf("error importing offline entry: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			resp.Duplicates++
+			continue
+		}
+
+		// 3. Count the visit and log the scan at the time it happened
+		event := accessEvent{
+			gymID:          req.GymId,
+			userID:         entry.UserId,
+			bookingID:      entry.BookingId,
+			direction:      entry.Direction,
+			at:             scannedAt,
+			deviceID:       req.DeviceId,
+			credentialType: credentialOffline,
+		}
+		if entry.BookingId != "" {
+			event.bookingType = subscriptionTypePersonal
+		}
+		if entry.Direction == accessDirectionEntry {
+			_, err = tx.Exec(ctx, `
+				INSERT INTO access_personal (booking_id, date, user_id)
+				VALUES ($1, $2, $3)
+			`, entry.BookingId, entry.ScannedAt, entry.UserId)
+			if err != nil {
+				return nil, fmt.Errorf("error creating access personal record: %w", err)
+			}
+			event.counted = true
+		}
+
+		recorded, err := insertAccessEvent(ctx, tx, event)
+		if err != nil {
+			return nil, err
+		}
+		published = append(published, recorded)
+
+		resp.Imported++
+		if overLimit {
+			resp.OverLimit++
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	for _, event := range published {
+		r.events.Publish(event)
+	}
+
+	return &resp, nil
+}
+
+// verifyOfflineUpload checks that req is signed with the key of an active
+// device registered to the gym.
+func (r *OfflineAccessRepo) verifyOfflineUpload(ctx context.Context, req *booking.ImportOfflineEntriesRequest) error {
+	var key string
+	err := r.db.QueryRow(ctx, `
+		SELECT key FROM offline_devices
+		WHERE device_id = $1 AND gym_id = $2 AND revoked_at IS NULL
+	`, req.DeviceId, req.GymId).Scan(&key)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return storage.Errorf(storage.ErrUnauthenticated, "device %s is not registered for this gym", req.DeviceId)
+		}
+		return fmt.Errorf("error getting offline device: %w", err)
+	}
+
+	unsigned := proto.Clone(req).(*booking.ImportOfflineEntriesRequest)
+	unsigned.Signature = ""
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(unsigned)
+	if err != nil {
+		return fmt.Errorf("error encoding offline upload: %w", err)
+	}
+	if !checkin.VerifySignature([]byte(key), payload, req.Signature) {
+		return storage.Errorf(storage.ErrUnauthenticated, "invalid upload signature")
+	}
+
+	return nil
+}
+
+// newOfflineDeviceKey generates a random key for a device to sign uploads.
+func newOfflineDeviceKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating offline device key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// planVersion identifies the plan version a booking was sold under.
+type planVersion struct {
+	subscriptionID string
+	version        int32
+}
+
+// listOfflineCredentials returns a credential for the holder and each member
+// of every granted personal booking at the gym, leaving out members who have
+// no visits left or whom the hall's gender rule keeps out.
+func listOfflineCredentials(ctx context.Context, q querier, gymID string) ([]*booking.OfflineCredential, error) {
+	rows, err := q.Query(ctx, `
+		SELECT
+			c.user_id::text,
+			bp.id,
+			bp.subscription_id,
+			bp.subscription_version,
+			bp.start_date,
+			bp.start_date + v.duration * INTERVAL '1 day',
+			CASE WHEN bp.count = -1 THEN -1
+				ELSE GREATEST(v.count - (SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = bp.id), 0)
+			END,
+			c.visit_limit,
+			(SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = bp.id AND ap.user_id = c.user_id)
+		FROM booking_personal bp
+		JOIN subscription_personal sp ON sp.id = bp.subscription_id
+		JOIN subscription_versions v ON v.subscription_type = 'personal'
+			AND v.subscription_id = bp.subscription_id
+			AND v.version = bp.subscription_version
+		CROSS JOIN LATERAL (
+			SELECT bp.user_id, 0
+			UNION ALL
+			SELECT bm.user_id, bm.visit_limit
+			FROM booking_members bm
+			WHERE bm.booking_type = 'personal' AND bm.booking_id = bp.id
+		) c(user_id, visit_limit)
+		WHERE sp.gym_id = $1 AND bp.access_status = 'granted'
+		ORDER BY bp.id, c.user_id
+	`, gymID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing offline credentials: %w", err)
+	}
+
+	type row struct {
+		credential *booking.OfflineCredential
+		version    planVersion
+	}
+	var all []row
+	for rows.Next() {
+		var (
+			credential          booking.OfflineCredential
+			version             planVersion
+			startDate, endDate  time.Time
+			bookingLeft         int32
+			visitLimit, usedOwn int32
+		)
+		err := rows.Scan(
+			&credential.UserId,
+			&credential.BookingId,
+			&version.subscriptionID,
+			&version.version,
+			&startDate,
+			&endDate,
+			&bookingLeft,
+			&visitLimit,
+			&usedOwn,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("error scanning offline credential: %w", err)
+		}
+
+		credential.StartDate = startDate.Format(time.RFC3339)
+		credential.EndDate = endDate.Format(time.RFC3339)
+		credential.VisitsLeft = combineVisitsLeft(bookingLeft, visitLimit, usedOwn)
+		if credential.VisitsLeft == 0 {
+			continue
+		}
+		all = append(all, row{credential: &credential, version: version})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error listing offline credentials: %w", err)
+	}
+
+	windows := make(map[planVersion][]*booking.TimeWindow)
+	var credentials []*booking.OfflineCredential
+	for _, r := range all {
+		reason, err := checkGenderPolicy(ctx, q, gymID, r.credential.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			continue
+		}
+
+		if _, ok := windows[r.version]; !ok {
+			windows[r.version], err = listTimeWindows(ctx, q, r.version.subscriptionID, r.version.version)
+			if err != nil {
+				return nil, err
+			}
+		}
+		r.credential.TimeWindows = windows[r.version]
+
+		credentials = append(credentials, r.credential)
+	}
+
+	return credentials, nil
+}
+
+// personalVisitsLeft returns how many visits the user has left on a personal
+// booking at the gym, -1 for unlimited. found is false when the booking is
+// not at the gym or the user neither holds nor shares it.
+func personalVisitsLeft(ctx context.Context, q querier, gymID, bookingID, userID string) (left int32, found bool, err error) {
+	var bookingLeft, visitLimit, usedOwn int32
+	err = q.QueryRow(ctx, `
+		SELECT
+			CASE WHEN bp.count = -1 THEN -1
+				ELSE GREATEST(v.count - (SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = bp.id), 0)
+			END,
+			COALESCE(bm.visit_limit, 0),
+			(SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = bp.id AND ap.user_id = $3)
+		FROM booking_personal bp
+		JOIN subscription_personal sp ON sp.id = bp.subscription_id
+		JOIN subscription_versions v ON v.subscription_type = 'personal'
+			AND v.subscription_id = bp.subscription_id
+			AND v.version = bp.subscription_version
+		LEFT JOIN booking_members bm ON bm.booking_type = 'personal' AND bm.booking_id = bp.id AND bm.user_id = $3
+		WHERE bp.id = $1 AND sp.gym_id = $2 AND (bp.user_id = $3 OR bm.user_id IS NOT NULL)
+	`, bookingID, gymID, userID).Scan(&bookingLeft, &visitLimit, &usedOwn)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("error getting visits left: %w", err)
+	}
+
+	return combineVisitsLeft(bookingLeft, visitLimit, usedOwn), true, nil
+}
+
+// combineVisitsLeft applies a member's own visit limit on top of the visits
+// left on the booking. -1 means unlimited.
+func combineVisitsLeft(bookingLeft, visitLimit, usedOwn int32) int32 {
+	if visitLimit <= 0 {
+		return bookingLeft
+	}
+	memberLeft := visitLimit - usedOwn
+	if memberLeft < 0 {
+		memberLeft = 0
+	}
+	if bookingLeft == -1 || memberLeft < bookingLeft {
+		return memberLeft
+	}
+	return bookingLeft
+}
+
+// encodeOfflineCredentials serializes a credential list for storage and
+// comparison between versions.
+func encodeOfflineCredentials(credentials []*booking.OfflineCredential) ([]byte, error) {
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(&booking.OfflineAccessSnapshot{Credentials: credentials})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding offline credentials: %w", err)
+	}
+	return encoded, nil
+}
+
+// diffOfflineCredentials returns the credentials added or changed in current
+// and the keys of those no longer present.
+func diffOfflineCredentials(base, current []*booking.OfflineCredential) (changed, removed []*booking.OfflineCredential) {
+	key := func(c *booking.OfflineCredential) string {
+		return c.BookingId + "|" + c.UserId
+	}
+
+	previous := make(map[string]*booking.OfflineCredential, len(base))
+	for _, c := range base {
+		previous[key(c)] = c
+	}
+
+	for _, c := range current {
+		if old, ok := previous[key(c)]; !ok || !proto.Equal(old, c) {
+			changed = append(changed, c)
+		}
+		delete(previous, key(c))
+	}
+
+	for _, c := range base {
+		if _, ok := previous[key(c)]; ok {
+			removed = append(removed, &booking.OfflineCredential{UserId: c.UserId, BookingId: c.BookingId})
+		}
+	}
+
+	return changed, removed
+}
@@ -0,0 +1,376 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+)
+
+// outboxMaxBackoff caps the wait before a failed event is published again.
+const outboxMaxBackoff = 5 * time.Minute
+
+// SubscriptionExpiredV1 reasons.
+const (
+	expiredReasonVisitsUsed  = "visits_used"  // the booking's last visit was used
+	expiredReasonPeriodEnded = "period_ended" // the booking's period ended first
+)
+
+// enqueueEvent writes an event that happened at gymID to the outbox, and a
+// delivery for each of the gym's webhooks subscribed to it. q must be the
+// transaction making the change the event describes, so the event is
+// published exactly when the change commits.
+func enqueueEvent(ctx context.Context, q querier, gymID, eventType string, version int32, aggregateType, aggregateID string, payload proto.Message) error {
+	envelope, err := events.New(eventType, version, aggregateType, aggregateID, payload)
+	if err != nil {
+		return err
+	}
+	envelope.GymId = gymID
+
+	_, err = q.Exec(ctx, `
+		INSERT INTO outbox (event_id, event_type, event_version, aggregate_type, aggregate_id, payload, gym_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, envelope.Id, envelope.Type, envelope.Version, envelope.AggregateType, envelope.AggregateId, envelope.Payload, gymID)
+	if err != nil {
+		return fmt.Errorf("error writing %s event: %w", eventType, err)
+	}
+
+	return enqueueWebhookDeliveries(ctx, q, envelope)
+}
+
+// enqueueEventOnce is enqueueEvent for events written at most once, such as
+// a booking's expiry, identified by dedupeKey. It reports whether the event
+// was written.
+func enqueueEventOnce(ctx context.Context, q querier, dedupeKey, gymID, eventType string, version int32, aggregateType, aggregateID string, payload proto.Message) (bool, error) {
+	result, err := q.Exec(ctx, `
+		INSERT INTO outbox_dedupe (dedupe_key) VALUES ($1)
+		ON CONFLICT DO NOTHING
+	`, dedupeKey)
+	if err != nil {
+		return false, fmt.Errorf("error deduplicating %s event: %w", eventType, err)
+	}
+	if result.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	return true, enqueueEvent(ctx, q, gymID, eventType, version, aggregateType, aggregateID, payload)
+}
+
+// expiredDedupeKey identifies the one SubscriptionExpiredV1 of a booking,
+// whichever way it expires.
+func expiredDedupeKey(bookingType, bookingID string) string {
+	return events.TypeSubscriptionExpired + ":" + bookingType + ":" + bookingID
+}
+
+// enqueueBookingEvent writes a booking created or cancelled event from the
+// booking's current row. Cancellations are enqueued before the row is
+// deleted.
+func enqueueBookingEvent(ctx context.Context, q querier, eventType, bookingType, bookingID string) error {
+	tables, err := lookupBookingTable(bookingType)
+	if err != nil {
+		return err
+	}
+
+	var (
+		created   booking.BookingCreatedV1
+		startDate time.Time
+	)
+	query := fmt.Sprintf(`
+		SELECT b.user_id, b.subscription_id, s.gym_id, b.payment, b.access_status, b.start_date
+		FROM %s b
+		JOIN subscription_%s s ON s.id = b.subscription_id
+		WHERE b.id = $1
+	`, tables.booking, bookingType)
+	err = q.QueryRow(ctx, query, bookingID).Scan(
+		&created.UserId,
+		&created.SubscriptionId,
+		&created.GymId,
+		&created.Payment,
+		&created.AccessStatus,
+		&startDate,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return err
+		}
+		return fmt.Errorf("error getting booking for %s event: %w", eventType, err)
+	}
+	created.BookingId = bookingID
+	created.BookingType = bookingType
+	created.StartDate = startDate.Format(time.RFC3339)
+
+	var payload proto.Message = &created
+	if eventType == events.TypeBookingCancelled {
+		payload = &booking.BookingCancelledV1{
+			BookingId:      bookingID,
+			BookingType:    bookingType,
+			UserId:         created.UserId,
+			SubscriptionId: created.SubscriptionId,
+			GymId:          created.GymId,
+		}
+	}
+
+	return enqueueEvent(ctx, q, created.GymId, eventType, 1, events.AggregateBooking, bookingID, payload)
+}
+
+// enqueueVisitEvents writes the events for a counted entry: access granted,
+// and subscription expired when the entry used the last visit of a personal
+// booking that has not expired yet.
+func enqueueVisitEvents(ctx context.Context, q querier, event *booking.AccessEvent) error {
+	err := enqueueEvent(ctx, q, event.GymId, events.TypeAccessGranted, 1, events.AggregateGym, event.GymId, &booking.AccessGrantedV1{
+		AccessEventId:  event.Id,
+		GymId:          event.GymId,
+		UserId:         event.UserId,
+		BookingId:      event.BookingId,
+		BookingType:    event.BookingType,
+		DeviceId:       event.DeviceId,
+		CredentialType: event.CredentialType,
+		OccurredAt:     event.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	if event.BookingType != subscriptionTypePersonal {
+		return nil
+	}
+
+	expired := booking.SubscriptionExpiredV1{
+		BookingId:   event.BookingId,
+		BookingType: event.BookingType,
+		GymId:       event.GymId,
+		Reason:      expiredReasonVisitsUsed,
+	}
+	// Unlimited bookings never run out
+	var visitsLeft int32
+	err = q.QueryRow(ctx, `
+		SELECT
+			bp.user_id,
+			bp.subscription_id,
+			CASE WHEN bp.count = -1 THEN -1
+				ELSE v.count - (SELECT COUNT(*) FROM access_personal ap WHERE ap.booking_id = bp.id)
+			END
+		FROM booking_personal bp
+		JOIN subscription_versions v ON v.subscription_type = 'personal'
+			AND v.subscription_id = bp.subscription_id
+			AND v.version = bp.subscription_version
+		WHERE bp.id = $1
+	`, event.BookingId).Scan(&expired.UserId, &expired.SubscriptionId, &visitsLeft)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("error checking visits left: %w", err)
+	}
+	if visitsLeft != 0 {
+		return nil
+	}
+
+	_, err = enqueueEventOnce(ctx, q, expiredDedupeKey(event.BookingType, event.BookingId),
+		event.GymId, events.TypeSubscriptionExpired, 1, events.AggregateBooking, event.BookingId, &expired)
+	return err
+}
+
+// OutboxRepo implements events.Store for the outbox table.
+type OutboxRepo struct {
+	db        *pgxpool.Pool
+	retention time.Duration // how long published events are kept; zero keeps them forever
+	logger    *slog.Logger
+}
+
+// NewOutboxRepo creates a new OutboxRepo.
+func NewOutboxRepo(db *pgxpool.Pool, retention time.Duration, logger *slog.Logger) *OutboxRepo {
+	return &OutboxRepo{
+		db:        db,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// EnqueueExpiries implements events.Store for personal bookings whose period
+// has ended without their last visit being used.
+func (r *OutboxRepo) EnqueueExpiries(ctx context.Context, limit int) (int, error) {
+	ctx, span := tracing.Start(ctx, "OutboxRepo.EnqueueExpiries")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT bp.id, bp.user_id, bp.subscription_id, sp.gym_id
+		FROM booking_personal bp
+		JOIN subscription_personal sp ON sp.id = bp.subscription_id
+		JOIN subscription_versions v ON v.subscription_type = 'personal'
+			AND v.subscription_id = bp.subscription_id
+			AND v.version = bp.subscription_version
+		WHERE bp.start_date + v.duration * INTERVAL '1 day' <= NOW()
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox_dedupe d
+				WHERE d.dedupe_key = $2 || bp.id
+			)
+		ORDER BY bp.start_date
+		LIMIT $1
+	`, limit, expiredDedupeKey(subscriptionTypePersonal, ""))
+	if err != nil {
+		return 0, fmt.Errorf("error finding ended bookings: %w", err)
+	}
+
+	var ended []*booking.SubscriptionExpiredV1
+	for rows.Next() {
+		expired := booking.SubscriptionExpiredV1{
+			BookingType: subscriptionTypePersonal,
+			Reason:      expiredReasonPeriodEnded,
+		}
+		if err := rows.Scan(&expired.BookingId, &expired.UserId, &expired.SubscriptionId, &expired.GymId); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("error finding ended bookings: %w", err)
+		}
+		ended = append(ended, &expired)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error finding ended bookings: %w", err)
+	}
+
+	// A concurrent sweep or last visit may have expired a booking since it
+	// was read; the dedupe key skips it
+	written := 0
+	for _, expired := range ended {
+		ok, err := enqueueEventOnce(ctx, tx, expiredDedupeKey(expired.BookingType, expired.BookingId),
+			expired.GymId, events.TypeSubscriptionExpired, 1, events.AggregateBooking, expired.BookingId, expired)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			written++
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return written, nil
+}
+
+// DeliverPending implements events.Store. Pending events stay locked while
+// they are delivered, so a second relay waits instead of publishing them
+// again.
+func (r *OutboxRepo) DeliverPending(ctx context.Context, limit int, deliver func(*booking.EventEnvelope) error) (int, error) {
+	ctx, span := tracing.Start(ctx, "OutboxRepo.DeliverPending")
+	defer span.End()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, event_id, event_type, event_version, aggregate_type, aggregate_id, payload, COALESCE(gym_id::text, ''), created_at, next_attempt_at <= NOW()
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE
+	`, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error reading outbox: %w", err)
+	}
+
+	type pending struct {
+		id       int64
+		envelope *booking.EventEnvelope
+		due      bool
+	}
+	var batch []pending
+	for rows.Next() {
+		var (
+			p         pending
+			envelope  booking.EventEnvelope
+			createdAt time.Time
+		)
+		err := rows.Scan(
+			&p.id,
+			&envelope.Id,
+			&envelope.Type,
+			&envelope.Version,
+			&envelope.AggregateType,
+			&envelope.AggregateId,
+			&envelope.Payload,
+			&envelope.GymId,
+			&createdAt,
+			&p.due,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("error reading outbox: %w", err)
+		}
+		envelope.OccurredAt = createdAt.UTC().Format(time.RFC3339)
+		p.envelope = &envelope
+		batch = append(batch, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error reading outbox: %w", err)
+	}
+
+	// Deliver in order up to the first event waiting for a retry or failing
+	var (
+		published  []int64
+		deliverErr error
+	)
+	for _, p := range batch {
+		if !p.due {
+			break
+		}
+		if publishErr := deliver(p.envelope); publishErr != nil {
+			deliverErr = fmt.Errorf("error publishing event %s: %w", p.envelope.Id, publishErr)
+			_, err := tx.Exec(ctx, `
+				UPDATE outbox
+				SET
+					attempts = attempts + 1,
+					last_error = $2,
+					next_attempt_at = NOW() + LEAST(POWER(2, attempts), $3) * INTERVAL '1 second'
+				WHERE id = $1
+			`, p.id, publishErr.Error(), outboxMaxBackoff.Seconds())
+			if err != nil {
+				return 0, fmt.Errorf("error scheduling event retry: %w", err)
+			}
+			break
+		}
+		published = append(published, p.id)
+	}
+
+	if len(published) > 0 {
+		_, err = tx.Exec(ctx, `UPDATE outbox SET published_at = NOW() WHERE id = ANY($1)`, published)
+		if err != nil {
+			return 0, fmt.Errorf("error marking events published: %w", err)
+		}
+	}
+
+	if r.retention > 0 {
+		_, err = tx.Exec(ctx, `
+			DELETE FROM outbox WHERE published_at < NOW() - make_interval(secs => $1)
+		`, r.retention.Seconds())
+		if err != nil {
+			return 0, fmt.Errorf("error pruning outbox: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(published), deliverErr
+}
@@ -0,0 +1,383 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/helper"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Pass types.
+const (
+	passTypeTrial = "trial"
+	passTypeGuest = "guest"
+)
+
+// PassRepo implements the PassRepoI interface for trial and guest passes.
+type PassRepo struct {
+	db     *pgxpool.Pool
+	logger *slog.Logger
+}
+
+// NewPassRepo creates a new PassRepo.
+func NewPassRepo(db *pgxpool.Pool, logger *slog.Logger) *PassRepo {
+	return &PassRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const passColumns = `id, gym_id, user_id, phone_number, type, visits, visits_used, price, valid_from, valid_until, revoked_at, created_at, updated_at`
+
+// IssuePass issues a new trial or guest pass. A person may hold only one trial
+// pass per gym, whether it was issued to their user ID or their phone number.
+func (r *PassRepo) IssuePass(ctx context.Context, req *booking.IssuePassRequest) (*booking.Pass, error) {
+	ctx, span := tracing.Start(ctx, "PassRepo.IssuePass")
+	defer span.End()
+
+	pass := req.Pass
+	if pass.Type != passTypeTrial && pass.Type != passTypeGuest {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "invalid pass type %q", pass.Type)
+	}
+	if pass.UserId == "" && pass.PhoneNumber == "" {
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "pass must be issued to a user or a phone number")
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 1. Make sure the person has not used a trial at this gym yet
+	if pass.Type == passTypeTrial {
+		var exists bool
+		err := tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1
+				FROM passes p
+				LEFT JOIN users u ON u.id = p.user_id
+				WHERE p.gym_id = $1 AND p.type = 'trial' AND (
+					p.user_id = NULLIF($2, '')::uuid OR
+					p.phone_number = NULLIF($3, '') OR
+					u.phone_number = NULLIF($3, '') OR
+					p.phone_number = (SELECT phone_number FROM users WHERE id = NULLIF($2, '')::uuid)
+				)
+			)
+		`, pass.GymId, pass.UserId, pass.PhoneNumber).Scan(&exists)
+		if err != nil {
+			return nil, fmt.Errorf("error checking existing trial passes: %w", err)
+		}
+		if exists {
+			return nil, storage.Errorf(storage.ErrAlreadyExists, "trial pass already issued for this gym")
+		}
+	}
+
+	// 2. Create the pass; single-day passes are valid for 24 hours by default
+	pass.Id = uuid.New().String()
+	query := `
+		INSERT INTO passes (
+			id,
+			gym_id,
+			user_id,
+			phone_number,
+			type,
+			visits,
+			price,
+			valid_from,
+			valid_until,
+			created_at,
+			updated_at
+		) VALUES (
+			$1, $2, NULLIF($3, '')::uuid, NULLIF($4, ''), $5, $6, $7,
+			COALESCE(NULLIF($8, '')::timestamp, NOW()),
+			COALESCE(NULLIF($9, '')::timestamp, COALESCE(NULLIF($8, '')::timestamp, NOW()) + INTERVAL '1 day'),
+			NOW(), NOW()
+		)
+		RETURNING ` + passColumns
+
+	row := tx.QueryRow(ctx, query,
+		pass.Id,
+		pass.GymId,
+		pass.UserId,
+		pass.PhoneNumber,
+		pass.Type,
+		pass.Visits,
+		pass.Price,
+		pass.ValidFrom,
+		pass.ValidUntil,
+	)
+	created, err := scanPass(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// GetPass retrieves a pass by ID.
+func (r *PassRepo) GetPass(ctx context.Context, req *booking.GetPassRequest) (*booking.Pass, error) {
+	ctx, span := tracing.Start(ctx, "PassRepo.GetPass")
+	defer span.End()
+
+	query := `SELECT ` + passColumns + ` FROM passes WHERE id = $1`
+
+	pass, err := scanPass(r.db.QueryRow(ctx, query, req.Id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, pgx.ErrNoRows
+		}
+		return nil, err
+	}
+	return pass, nil
+}
+
+// ListPasses retrieves passes with optional filtering.
+func (r *PassRepo) ListPasses(ctx context.Context, req *booking.ListPassesRequest) (*booking.ListPassesResponse, error) {
+	ctx, span := tracing.Start(ctx, "PassRepo.ListPasses")
+	defer span.End()
+
+	var args []interface{}
+	count := 1
+	query := `SELECT ` + passColumns + ` FROM passes WHERE 1=1`
+
+	if req.GymId != "" {
+		query += fmt.Sprintf(" AND gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+	if req.UserId != "" {
+		query += fmt.Sprintf(" AND user_id = $%d", count)
+		args = append(args, req.UserId)
+		count++
+	}
+	if req.PhoneNumber != "" {
+		query += fmt.Sprintf(" AND phone_number = $%d", count)
+		args = append(args, req.PhoneNumber)
+		count++
+	}
+	if req.Type != "" {
+		query += fmt.Sprintf(" AND type = $%d", count)
+		args = append(args, req.Type)
+		count++
+	}
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var passes []*booking.Pass
+
+	for rows.Next() {
+		pass, err := scanPass(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		passes = append(passes, pass)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return &booking.ListPassesResponse{Passes: passes}, nil
+}
+
+// RevokePass revokes a pass so it can no longer be redeemed.
+func (r *PassRepo) RevokePass(ctx context.Context, req *booking.RevokePassRequest) error {
+	ctx, span := tracing.Start(ctx, "PassRepo.RevokePass")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE passes
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := tx.Exec(ctx, query, req.Id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTrialConversionReport reports how many trial holders at a gym went on to
+// buy a personal, group or coach booking there after receiving the trial.
+func (r *PassRepo) GetTrialConversionReport(ctx context.Context, req *booking.TrialConversionReportRequest) (*booking.TrialConversionReport, error) {
+	ctx, span := tracing.Start(ctx, "PassRepo.GetTrialConversionReport")
+	defer span.End()
+
+	args := []interface{}{req.GymId}
+	count := 2
+	filter := ""
+
+	if req.From != "" {
+		filter += fmt.Sprintf(" AND p.created_at >= $%d", count)
+		args = append(args, req.From)
+		count++
+	}
+	if req.To != "" {
+		filter += fmt.Sprintf(" AND p.created_at < $%d", count)
+		args = append(args, req.To)
+		count++
+	}
+
+	query := `
+		WITH trials AS (
+			SELECT p.id, p.created_at, p.visits_used, COALESCE(p.user_id, u.id) AS user_id
+			FROM passes p
+			LEFT JOIN users u ON p.user_id IS NULL AND u.phone_number = p.phone_number
+			WHERE p.gym_id = $1 AND p.type = 'trial'` + filter + `
+		)
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE t.visits_used > 0),
+			COUNT(*) FILTER (WHERE
+				EXISTS (
+					SELECT 1 FROM booking_personal b
+					JOIN subscription_personal s ON s.id = b.subscription_id
+					WHERE b.user_id = t.user_id AND s.gym_id = $1 AND b.created_at >= t.created_at
+				) OR EXISTS (
+					SELECT 1 FROM booking_group b
+					JOIN subscription_group s ON s.id = b.subscription_id
+					WHERE b.user_id = t.user_id AND s.gym_id = $1 AND b.created_at >= t.created_at
+				) OR EXISTS (
+					SELECT 1 FROM booking_coach b
+					JOIN subscription_coach s ON s.id = b.subscription_id
+					WHERE b.user_id = t.user_id AND s.gym_id = $1 AND b.created_at >= t.created_at
+				)
+			)
+		FROM trials t
+	`
+
+	report := booking.TrialConversionReport{GymId: req.GymId}
+	err := r.db.QueryRow(ctx, query, args...).Scan(
+		&report.TrialsIssued,
+		&report.TrialsRedeemed,
+		&report.Converted,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error building trial conversion report: %w", err)
+	}
+
+	if report.TrialsIssued > 0 {
+		report.ConversionRate = float32(report.Converted) / float32(report.TrialsIssued)
+	}
+
+	return &report, nil
+}
+
+// redeemPass uses one visit of a valid pass held by the user at the gym and
+// logs the visit. Passes issued to the user's phone number count as theirs.
+// It returns an empty pass ID when the user holds no redeemable pass. The
+// visit is written on tx, for the caller to commit with the access event.
+func redeemPass(ctx context.Context, tx pgx.Tx, userID, gymID string) (string, error) {
+	var passID string
+	err := tx.QueryRow(ctx, `
+		UPDATE passes
+		SET visits_used = visits_used + 1, updated_at = NOW()
+		WHERE id = (
+			SELECT p.id
+			FROM passes p
+			WHERE p.gym_id = $2 AND p.revoked_at IS NULL
+			AND p.valid_from <= NOW() AND p.valid_until > NOW()
+			AND (p.visits = 0 OR p.visits_used < p.visits)
+			AND (p.user_id = $1 OR p.phone_number = (SELECT phone_number FROM users WHERE id = $1))
+			ORDER BY p.valid_until
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`, userID, gymID).Scan(&passID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("error redeeming pass: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO access_pass (pass_id, date) VALUES ($1, NOW())`, passID)
+	if err != nil {
+		return "", fmt.Errorf("error creating access pass record: %w", err)
+	}
+
+	return passID, nil
+}
+
+// scanPass scans a passes row selected with passColumns.
+func scanPass(row pgx.Row) (*booking.Pass, error) {
+	var (
+		pass        booking.Pass
+		userID      *string
+		phoneNumber *string
+		validFrom   time.Time
+		validUntil  time.Time
+		revokedAt   sql.NullTime
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+
+	err := row.Scan(
+		&pass.Id,
+		&pass.GymId,
+		&userID,
+		&phoneNumber,
+		&pass.Type,
+		&pass.Visits,
+		&pass.VisitsUsed,
+		&pass.Price,
+		&validFrom,
+		&validUntil,
+		&revokedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID != nil {
+		pass.UserId = *userID
+	}
+	if phoneNumber != nil {
+		pass.PhoneNumber = *phoneNumber
+	}
+	pass.ValidFrom = validFrom.Format(time.RFC3339)
+	pass.ValidUntil = validUntil.Format(time.RFC3339)
+	pass.RevokedAt = helper.DateToString(revokedAt)
+	pass.CreatedAt = createdAt.Format(time.RFC3339)
+	pass.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return &pass, nil
+}
@@ -6,13 +6,28 @@ import (
 	"log/slog"
 
 	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/metrics"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
 	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// querier is satisfied by both *pgxpool.Pool and pgx.Tx, so shared helpers can
+// run inside or outside a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 // StorageP implements the storage.StorageI interface for PostgreSQL.
 type StorageP struct {
-	db                       *pgx.Conn
+	db                       *pgxpool.Pool
 	bookingPersonalRepo      storage.BookingPersonalRepoI
 	bookingGroupRepo         storage.BookingGroupRepoI
 	bookingCoachRepo         storage.BookingCoachRepoI
@@ -21,40 +36,44 @@ type StorageP struct {
 	subscriptionCoachRepo    storage.SubscriptionCoachRepoI
 	accessRepo               storage.AccessRepoI
 	accessBetaRepo           storage.AccessRepoBetaI
+	passRepo                 storage.PassRepoI
+	bookingMemberRepo        storage.BookingMemberRepoI
+	bookingTransferRepo      storage.BookingTransferRepoI
+	bundleRepo               storage.BundleRepoI
+	genderOverrideRepo       storage.GenderOverrideRepoI
+	occupancyRepo            storage.OccupancyRepoI
+	offlineAccessRepo        storage.OfflineAccessRepoI
+	auditRepo                storage.AuditRepoI
+	webhookRepo              storage.WebhookRepoI
 }
 
-// NewPostgresStorage creates a new PostgreSQL storage instance.
-func NewPostgresStorage(cfg config.Config) (storage.StorageI, error) {
-	dbCon := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s",
-		cfg.PostgresUser,
-		cfg.PostgresPassword,
-		cfg.PostgresHost,
-		cfg.PostgresPort,
-		cfg.PostgresDB,
-	)
-
-	db, err := pgx.Connect(context.Background(), dbCon)
-	if err != nil {
-		slog.Warn("Unable to connect to database:" + err.Error())
-		return nil, err
-	}
-
-	if err := db.Ping(context.Background()); err != nil {
-		slog.Warn("Unable to ping database:" + err.Error())
-		return nil, err
-	}
+// NewPostgresStorage creates a new PostgreSQL storage instance on the pool
+// db. Repos that log write to logger.
+func NewPostgresStorage(db *pgxpool.Pool, cfg config.Config, logger *slog.Logger) storage.StorageI {
+	// Occupancy changes are published by the access paths and read by watchers
+	occupancy := pubsub.NewBroker[*booking.Occupancy](16)
+	accessEvents := pubsub.NewBroker[*booking.AccessEvent](64)
 
 	return &StorageP{
 		db:                       db,
-		bookingPersonalRepo:      NewBookingPersonalRepo(db),
-		bookingGroupRepo:         NewBookingGroupRepo(db),
-		bookingCoachRepo:         NewBookingCoachRepo(db),
-		subscriptionPersonalRepo: NewSubscriptionPersonalRepo(db),
-		subscriptionGroupRepo:    NewSubscriptionGroupRepo(db),
-		subscriptionCoachRepo:    NewSubscriptionCoachRepo(db),
-		accessRepo:               NewAccessRepo(db),
-		accessBetaRepo:           NewAccessBetaRepo(db),
-	}, nil
+		bookingPersonalRepo:      NewBookingPersonalRepo(db, logger),
+		bookingGroupRepo:         NewBookingGroupRepo(db, logger),
+		bookingCoachRepo:         NewBookingCoachRepo(db, logger),
+		subscriptionPersonalRepo: NewSubscriptionPersonalRepo(db, logger),
+		subscriptionGroupRepo:    NewSubscriptionGroupRepo(db, logger),
+		subscriptionCoachRepo:    NewSubscriptionCoachRepo(db, logger),
+		accessRepo:               NewAccessRepo(db, accessEvents, logger),
+		accessBetaRepo:           NewAccessBetaRepo(db, cfg, occupancy, accessEvents, logger),
+		passRepo:                 NewPassRepo(db, logger),
+		bookingMemberRepo:        NewBookingMemberRepo(db, logger),
+		bookingTransferRepo:      NewBookingTransferRepo(db, logger),
+		bundleRepo:               NewBundleRepo(db, logger),
+		genderOverrideRepo:       NewGenderOverrideRepo(db, logger),
+		occupancyRepo:            NewOccupancyRepo(db, cfg, occupancy, logger),
+		offlineAccessRepo:        NewOfflineAccessRepo(db, cfg, accessEvents, logger),
+		auditRepo:                NewAuditRepo(db, logger),
+		webhookRepo:              NewWebhookRepo(db, cfg, logger),
+	}
 }
 
 // BookingPersonal returns the BookingPersonalRepoI implementation for PostgreSQL.
@@ -96,3 +115,118 @@ func (s *StorageP) Access() storage.AccessRepoI {
 func (s *StorageP) AccessBeta() storage.AccessRepoBetaI {
 	return s.accessBetaRepo
 }
+
+// Pass returns the PassRepoI implementation for PostgreSQL.
+func (s *StorageP) Pass() storage.PassRepoI {
+	return s.passRepo
+}
+
+// BookingMember returns the BookingMemberRepoI implementation for PostgreSQL.
+func (s *StorageP) BookingMember() storage.BookingMemberRepoI {
+	return s.bookingMemberRepo
+}
+
+// BookingTransfer returns the BookingTransferRepoI implementation for PostgreSQL.
+func (s *StorageP) BookingTransfer() storage.BookingTransferRepoI {
+	return s.bookingTransferRepo
+}
+
+// Bundle returns the BundleRepoI implementation for PostgreSQL.
+func (s *StorageP) Bundle() storage.BundleRepoI {
+	return s.bundleRepo
+}
+
+// GenderOverride returns the GenderOverrideRepoI implementation for PostgreSQL.
+func (s *StorageP) GenderOverride() storage.GenderOverrideRepoI {
+	return s.genderOverrideRepo
+}
+
+// Occupancy returns the OccupancyRepoI implementation for PostgreSQL.
+func (s *StorageP) Occupancy() storage.OccupancyRepoI {
+	return s.occupancyRepo
+}
+
+// OfflineAccess returns the OfflineAccessRepoI implementation for PostgreSQL.
+func (s *StorageP) OfflineAccess() storage.OfflineAccessRepoI {
+	return s.offlineAccessRepo
+}
+
+// Audit returns the AuditRepoI implementation for PostgreSQL.
+func (s *StorageP) Audit() storage.AuditRepoI {
+	return s.auditRepo
+}
+
+// Webhook returns the WebhookRepoI implementation for PostgreSQL.
+func (s *StorageP) Webhook() storage.WebhookRepoI {
+	return s.webhookRepo
+}
+
+// Ping checks that the database can be reached.
+func (s *StorageP) Ping(ctx context.Context) error {
+	return s.db.Ping(ctx)
+}
+
+// Close closes every connection in the pool once it is released.
+func (s *StorageP) Close(ctx context.Context) error {
+	s.db.Close()
+	return nil
+}
+
+// Connect opens a pool of connections to the PostgreSQL database in cfg.
+// Request handlers and background workers share it, each statement or
+// transaction holding a connection only while it runs.
+func Connect(cfg config.Config) (*pgxpool.Pool, error) {
+	dbCon := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s",
+		cfg.PostgresUser,
+		cfg.PostgresPassword,
+		cfg.PostgresHost,
+		cfg.PostgresPort,
+		cfg.PostgresDB,
+	)
+
+	poolConfig, err := pgxpool.ParseConfig(dbCon)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PostgresMaxConns > 0 {
+		poolConfig.MaxConns = cfg.PostgresMaxConns
+	}
+	poolConfig.ConnConfig.Tracer = connTracer{metrics: metrics.DBTracer{}, tracing: tracing.DBTracer{}}
+
+	db, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to database: %w", err)
+	}
+
+	if err := db.Ping(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("unable to ping database: %w", err)
+	}
+
+	return db, nil
+}
+
+// connTracer reports every statement and connection attempt to both the
+// metrics and the tracing tracer.
+type connTracer struct {
+	metrics metrics.DBTracer
+	tracing tracing.DBTracer
+}
+
+func (t connTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx = t.metrics.TraceQueryStart(ctx, conn, data)
+	return t.tracing.TraceQueryStart(ctx, conn, data)
+}
+
+func (t connTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.tracing.TraceQueryEnd(ctx, conn, data)
+	t.metrics.TraceQueryEnd(ctx, conn, data)
+}
+
+func (t connTracer) TraceConnectStart(ctx context.Context, data pgx.TraceConnectStartData) context.Context {
+	return t.metrics.TraceConnectStart(ctx, data)
+}
+
+func (t connTracer) TraceConnectEnd(ctx context.Context, data pgx.TraceConnectEndData) {
+	t.metrics.TraceConnectEnd(ctx, data)
+}
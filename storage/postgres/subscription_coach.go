@@ -2,27 +2,35 @@ package postgres
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // SubscriptionCoachRepo implements the SubscriptionRepoI interface for SubscriptionCoach entities.
 type SubscriptionCoachRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
 // NewSubscriptionCoachRepo creates a new SubscriptionCoachRepo.
-func NewSubscriptionCoachRepo(db *pgx.Conn) *SubscriptionCoachRepo {
+func NewSubscriptionCoachRepo(db *pgxpool.Pool, logger *slog.Logger) *SubscriptionCoachRepo {
 	return &SubscriptionCoachRepo{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 
 // CreateSubscriptionCoach creates a new subscription coach record.
 func (r *SubscriptionCoachRepo) CreateSubscriptionCoach(ctx context.Context, req *booking.CreateSubscriptionCoachRequest) (*booking.SubscriptionCoach, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionCoachRepo.CreateSubscriptionCoach")
+	defer span.End()
+
 	req.SubscriptionCoach.Id = uuid.New().String()
 	query := `
 		INSERT INTO subscription_coach (
@@ -33,10 +41,11 @@ func (r *SubscriptionCoachRepo) CreateSubscriptionCoach(ctx context.Context, req
 			description,
 			price,
 			duration,
+			version,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id, gym_id, coach_id, type, description, price, duration, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, 1, NOW(), NOW())
+		RETURNING id, gym_id, coach_id, type, description, price, duration, version, created_at, updated_at
 	`
 
 	var (
@@ -44,7 +53,13 @@ func (r *SubscriptionCoachRepo) CreateSubscriptionCoach(ctx context.Context, req
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		req.SubscriptionCoach.Id,
 		req.SubscriptionCoach.GymId,
 		req.SubscriptionCoach.CoachId,
@@ -60,6 +75,7 @@ func (r *SubscriptionCoachRepo) CreateSubscriptionCoach(ctx context.Context, req
 		&req.SubscriptionCoach.Description,
 		&req.SubscriptionCoach.Price,
 		&req.SubscriptionCoach.Duration,
+		&req.SubscriptionCoach.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -68,6 +84,19 @@ func (r *SubscriptionCoachRepo) CreateSubscriptionCoach(ctx context.Context, req
 		return nil, err
 	}
 
+	err = insertSubscriptionVersion(ctx, tx, subscriptionTypeCoach, req.SubscriptionCoach.Id, req.SubscriptionCoach.Version,
+		req.SubscriptionCoach.Price,
+		req.SubscriptionCoach.Duration,
+		0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	req.SubscriptionCoach.CreatedAt = createdAt.Format(time.RFC3339)
 	req.SubscriptionCoach.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -76,6 +105,9 @@ func (r *SubscriptionCoachRepo) CreateSubscriptionCoach(ctx context.Context, req
 
 // GetSubscriptionCoach retrieves a subscription coach record by ID.
 func (r *SubscriptionCoachRepo) GetSubscriptionCoach(ctx context.Context, req *booking.GetSubscriptionCoachRequest) (*booking.SubscriptionCoach, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionCoachRepo.GetSubscriptionCoach")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -85,6 +117,7 @@ func (r *SubscriptionCoachRepo) GetSubscriptionCoach(ctx context.Context, req *b
 			description,
 			price,
 			duration,
+			version,
 			created_at,
 			updated_at
 		FROM subscription_coach
@@ -105,6 +138,7 @@ func (r *SubscriptionCoachRepo) GetSubscriptionCoach(ctx context.Context, req *b
 		&subscription.Description,
 		&subscription.Price,
 		&subscription.Duration,
+		&subscription.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -124,6 +158,25 @@ func (r *SubscriptionCoachRepo) GetSubscriptionCoach(ctx context.Context, req *b
 
 // UpdateSubscriptionCoach updates an existing subscription coach record.
 func (r *SubscriptionCoachRepo) UpdateSubscriptionCoach(ctx context.Context, req *booking.UpdateSubscriptionCoachRequest) (*booking.SubscriptionCoach, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionCoachRepo.UpdateSubscriptionCoach")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := nextSubscriptionVersion(ctx, tx, subscriptionTypeCoach, req.SubscriptionCoach.Id,
+		req.SubscriptionCoach.Price,
+		req.SubscriptionCoach.Duration,
+		0,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 		UPDATE subscription_coach
 		SET
@@ -133,9 +186,10 @@ func (r *SubscriptionCoachRepo) UpdateSubscriptionCoach(ctx context.Context, req
 			description = $4,
 			price = $5,
 			duration = $6,
+			version = $7,
 			updated_at = NOW()
-		WHERE id = $7
-		RETURNING id, gym_id, coach_id, type, description, price, duration, created_at, updated_at
+		WHERE id = $8
+		RETURNING id, gym_id, coach_id, type, description, price, duration, version, created_at, updated_at
 	`
 
 	var (
@@ -143,13 +197,14 @@ func (r *SubscriptionCoachRepo) UpdateSubscriptionCoach(ctx context.Context, req
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.SubscriptionCoach.GymId,
 		req.SubscriptionCoach.CoachId,
 		req.SubscriptionCoach.Type,
 		req.SubscriptionCoach.Description,
 		req.SubscriptionCoach.Price,
 		req.SubscriptionCoach.Duration,
+		version,
 		req.SubscriptionCoach.Id,
 	).Scan(
 		&req.SubscriptionCoach.Id,
@@ -159,6 +214,7 @@ func (r *SubscriptionCoachRepo) UpdateSubscriptionCoach(ctx context.Context, req
 		&req.SubscriptionCoach.Description,
 		&req.SubscriptionCoach.Price,
 		&req.SubscriptionCoach.Duration,
+		&req.SubscriptionCoach.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -170,6 +226,10 @@ func (r *SubscriptionCoachRepo) UpdateSubscriptionCoach(ctx context.Context, req
 		return nil, err
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	req.SubscriptionCoach.CreatedAt = createdAt.Format(time.RFC3339)
 	req.SubscriptionCoach.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -178,12 +238,21 @@ func (r *SubscriptionCoachRepo) UpdateSubscriptionCoach(ctx context.Context, req
 
 // DeleteSubscriptionCoach deletes a subscription coach record by ID.
 func (r *SubscriptionCoachRepo) DeleteSubscriptionCoach(ctx context.Context, req *booking.DeleteSubscriptionCoachRequest) error {
+	ctx, span := tracing.Start(ctx, "SubscriptionCoachRepo.DeleteSubscriptionCoach")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		DELETE FROM subscription_coach
 		WHERE id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, req.Id)
+	result, err := tx.Exec(ctx, query, req.Id)
 	if err != nil {
 		return err
 	}
@@ -192,11 +261,18 @@ func (r *SubscriptionCoachRepo) DeleteSubscriptionCoach(ctx context.Context, req
 		return pgx.ErrNoRows
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ListSubscriptionCoach retrieves a list of subscription coach records by gym ID.
 func (r *SubscriptionCoachRepo) ListSubscriptionCoach(ctx context.Context, req *booking.ListSubscriptionCoachRequest) (*booking.ListSubscriptionCoachResponse, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionCoachRepo.ListSubscriptionCoach")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -206,6 +282,7 @@ func (r *SubscriptionCoachRepo) ListSubscriptionCoach(ctx context.Context, req *
 			description,
 			price,
 			duration,
+			version,
 			created_at,
 			updated_at
 		FROM subscription_coach
@@ -235,6 +312,7 @@ func (r *SubscriptionCoachRepo) ListSubscriptionCoach(ctx context.Context, req *
 			&subscription.Description,
 			&subscription.Price,
 			&subscription.Duration,
+			&subscription.Version,
 			&createdAt,
 			&updatedAt,
 		)
@@ -251,3 +329,11 @@ func (r *SubscriptionCoachRepo) ListSubscriptionCoach(ctx context.Context, req *
 
 	return &booking.ListSubscriptionCoachResponse{SubscriptionCoach: subscriptions}, nil
 }
+
+// ListSubscriptionCoachVersions returns the full version history of a coach subscription.
+func (r *SubscriptionCoachRepo) ListSubscriptionCoachVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionCoachRepo.ListSubscriptionCoachVersions")
+	defer span.End()
+
+	return listSubscriptionVersions(ctx, r.db, subscriptionTypeCoach, req.SubscriptionId)
+}
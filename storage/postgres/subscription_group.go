@@ -2,27 +2,35 @@ package postgres
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // SubscriptionGroupRepo implements the SubscriptionRepoI interface for SubscriptionGroup entities.
 type SubscriptionGroupRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
 // NewSubscriptionGroupRepo creates a new SubscriptionGroupRepo.
-func NewSubscriptionGroupRepo(db *pgx.Conn) *SubscriptionGroupRepo {
+func NewSubscriptionGroupRepo(db *pgxpool.Pool, logger *slog.Logger) *SubscriptionGroupRepo {
 	return &SubscriptionGroupRepo{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 
 // CreateSubscriptionGroup creates a new subscription group record.
 func (r *SubscriptionGroupRepo) CreateSubscriptionGroup(ctx context.Context, req *booking.CreateSubscriptionGroupRequest) (*booking.SubscriptionGroup, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionGroupRepo.CreateSubscriptionGroup")
+	defer span.End()
+
 	req.SubscriptionGroup.Id = uuid.New().String()
 	query := `
 		INSERT INTO subscription_group (
@@ -36,10 +44,11 @@ func (r *SubscriptionGroupRepo) CreateSubscriptionGroup(ctx context.Context, req
 			time,
 			duration,
 			count,
+			version,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
-		RETURNING id, gym_id, coach_id, type, description, price, capacity, time, duration, count, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 1, NOW(), NOW())
+		RETURNING id, gym_id, coach_id, type, description, price, capacity, time, duration, count, version, created_at, updated_at
 	`
 
 	var (
@@ -48,7 +57,13 @@ func (r *SubscriptionGroupRepo) CreateSubscriptionGroup(ctx context.Context, req
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		req.SubscriptionGroup.Id,
 		req.SubscriptionGroup.GymId,
 		req.SubscriptionGroup.CoachId,
@@ -70,6 +85,7 @@ func (r *SubscriptionGroupRepo) CreateSubscriptionGroup(ctx context.Context, req
 		&timeT, // No conversion needed
 		&req.SubscriptionGroup.Duration,
 		&req.SubscriptionGroup.Count,
+		&req.SubscriptionGroup.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -77,6 +93,19 @@ func (r *SubscriptionGroupRepo) CreateSubscriptionGroup(ctx context.Context, req
 	if err != nil {
 		return nil, err
 	}
+
+	err = insertSubscriptionVersion(ctx, tx, subscriptionTypeGroup, req.SubscriptionGroup.Id, req.SubscriptionGroup.Version,
+		req.SubscriptionGroup.Price,
+		req.SubscriptionGroup.Duration,
+		req.SubscriptionGroup.Count,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	req.SubscriptionGroup.Time = timeT.Format(time.RFC3339)
 	req.SubscriptionGroup.CreatedAt = createdAt.Format(time.RFC3339)
 	req.SubscriptionGroup.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -86,6 +115,9 @@ func (r *SubscriptionGroupRepo) CreateSubscriptionGroup(ctx context.Context, req
 
 // GetSubscriptionGroup retrieves a subscription group record by ID.
 func (r *SubscriptionGroupRepo) GetSubscriptionGroup(ctx context.Context, req *booking.GetSubscriptionGroupRequest) (*booking.SubscriptionGroup, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionGroupRepo.GetSubscriptionGroup")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -98,6 +130,7 @@ func (r *SubscriptionGroupRepo) GetSubscriptionGroup(ctx context.Context, req *b
 			time,
 			duration,
 			count,
+			version,
 			created_at,
 			updated_at
 		FROM subscription_group
@@ -122,6 +155,7 @@ func (r *SubscriptionGroupRepo) GetSubscriptionGroup(ctx context.Context, req *b
 		&timeT, // Scan into time.Time
 		&subscription.Duration,
 		&subscription.Count,
+		&subscription.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -142,6 +176,25 @@ func (r *SubscriptionGroupRepo) GetSubscriptionGroup(ctx context.Context, req *b
 
 // UpdateSubscriptionGroup updates an existing subscription group record.
 func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req *booking.UpdateSubscriptionGroupRequest) (*booking.SubscriptionGroup, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionGroupRepo.UpdateSubscriptionGroup")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := nextSubscriptionVersion(ctx, tx, subscriptionTypeGroup, req.SubscriptionGroup.Id,
+		req.SubscriptionGroup.Price,
+		req.SubscriptionGroup.Duration,
+		req.SubscriptionGroup.Count,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 		UPDATE subscription_group
 		SET
@@ -154,9 +207,10 @@ func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req
 			time = $7,
 			duration = $8,
 			count = $9,
+			version = $10,
 			updated_at = NOW()
-		WHERE id = $10
-		RETURNING id, gym_id, coach_id, type, description, price, capacity, time, duration, count, created_at, updated_at
+		WHERE id = $11
+		RETURNING id, gym_id, coach_id, type, description, price, capacity, time, duration, count, version, created_at, updated_at
 	`
 
 	var (
@@ -165,7 +219,7 @@ func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.SubscriptionGroup.GymId,
 		req.SubscriptionGroup.CoachId,
 		req.SubscriptionGroup.Type,
@@ -175,6 +229,7 @@ func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req
 		req.SubscriptionGroup.Time, // No conversion needed
 		req.SubscriptionGroup.Duration,
 		req.SubscriptionGroup.Count,
+		version,
 		req.SubscriptionGroup.Id,
 	).Scan(
 		&req.SubscriptionGroup.Id,
@@ -187,6 +242,7 @@ func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req
 		&timeT, // No conversion needed
 		&req.SubscriptionGroup.Duration,
 		&req.SubscriptionGroup.Count,
+		&req.SubscriptionGroup.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -197,6 +253,10 @@ func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req
 		}
 		return nil, err
 	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	req.SubscriptionGroup.Time = timeT.Format(time.RFC3339)
 	req.SubscriptionGroup.CreatedAt = createdAt.Format(time.RFC3339)
 	req.SubscriptionGroup.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -206,12 +266,21 @@ func (r *SubscriptionGroupRepo) UpdateSubscriptionGroup(ctx context.Context, req
 
 // DeleteSubscriptionGroup deletes a subscription group record by ID.
 func (r *SubscriptionGroupRepo) DeleteSubscriptionGroup(ctx context.Context, req *booking.DeleteSubscriptionGroupRequest) error {
+	ctx, span := tracing.Start(ctx, "SubscriptionGroupRepo.DeleteSubscriptionGroup")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		DELETE FROM subscription_group
 		WHERE id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, req.Id)
+	result, err := tx.Exec(ctx, query, req.Id)
 	if err != nil {
 		return err
 	}
@@ -220,11 +289,18 @@ func (r *SubscriptionGroupRepo) DeleteSubscriptionGroup(ctx context.Context, req
 		return pgx.ErrNoRows
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // ListSubscriptionGroup retrieves a list of subscription group records by gym ID.
 func (r *SubscriptionGroupRepo) ListSubscriptionGroup(ctx context.Context, req *booking.ListSubscriptionGroupRequest) (*booking.ListSubscriptionGroupResponse, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionGroupRepo.ListSubscriptionGroup")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -237,6 +313,7 @@ func (r *SubscriptionGroupRepo) ListSubscriptionGroup(ctx context.Context, req *
 			time,
 			duration,
 			count,
+			version,
 			created_at,
 			updated_at
 		FROM subscription_group
@@ -270,6 +347,7 @@ func (r *SubscriptionGroupRepo) ListSubscriptionGroup(ctx context.Context, req *
 			&timeT, // Scan into time.Time
 			&subscription.Duration,
 			&subscription.Count,
+			&subscription.Version,
 			&createdAt,
 			&updatedAt,
 		)
@@ -287,3 +365,11 @@ func (r *SubscriptionGroupRepo) ListSubscriptionGroup(ctx context.Context, req *
 
 	return &booking.ListSubscriptionGroupResponse{SubscriptionGroup: subscriptions}, nil
 }
+
+// ListSubscriptionGroupVersions returns the full version history of a group subscription.
+func (r *SubscriptionGroupRepo) ListSubscriptionGroupVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionGroupRepo.ListSubscriptionGroupVersions")
+	defer span.End()
+
+	return listSubscriptionVersions(ctx, r.db, subscriptionTypeGroup, req.SubscriptionId)
+}
@@ -2,24 +2,35 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"sort"
 	"time"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type SubscriptionPersonalRepo struct {
-	db *pgx.Conn
+	db     *pgxpool.Pool
+	logger *slog.Logger
 }
 
 // NewSubscriptionPersonalRepo creates a new SubscriptionPersonalRepo.
-func NewSubscriptionPersonalRepo(db *pgx.Conn) *SubscriptionPersonalRepo {
+func NewSubscriptionPersonalRepo(db *pgxpool.Pool, logger *slog.Logger) *SubscriptionPersonalRepo {
 	return &SubscriptionPersonalRepo{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 func (r *SubscriptionPersonalRepo) CreateSubscriptionPersonal(ctx context.Context, req *booking.CreateSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionPersonalRepo.CreateSubscriptionPersonal")
+	defer span.End()
+
 	req.SubscriptionPersonal.Id = uuid.New().String()
 	query := `
 		INSERT INTO subscription_personal (
@@ -30,10 +41,11 @@ func (r *SubscriptionPersonalRepo) CreateSubscriptionPersonal(ctx context.Contex
 			price,
 			duration,
 			count,
+			version,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
-		RETURNING id, gym_id, type, description, price, duration, count, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, 1, NOW(), NOW())
+		RETURNING id, gym_id, type, description, price, duration, count, version, created_at, updated_at
 	`
 
 	var (
@@ -41,7 +53,13 @@ func (r *SubscriptionPersonalRepo) CreateSubscriptionPersonal(ctx context.Contex
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		req.SubscriptionPersonal.Id,
 		req.SubscriptionPersonal.GymId,
 		req.SubscriptionPersonal.Type,
@@ -57,6 +75,7 @@ func (r *SubscriptionPersonalRepo) CreateSubscriptionPersonal(ctx context.Contex
 		&req.SubscriptionPersonal.Price,
 		&req.SubscriptionPersonal.Duration,
 		&req.SubscriptionPersonal.Count,
+		&req.SubscriptionPersonal.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -65,6 +84,27 @@ func (r *SubscriptionPersonalRepo) CreateSubscriptionPersonal(ctx context.Contex
 		return nil, err
 	}
 
+	err = insertSubscriptionVersion(ctx, tx, subscriptionTypePersonal, req.SubscriptionPersonal.Id, req.SubscriptionPersonal.Version,
+		req.SubscriptionPersonal.Price,
+		req.SubscriptionPersonal.Duration,
+		req.SubscriptionPersonal.Count,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SubscriptionPersonal.TimeWindows, err = normalizeTimeWindows(req.SubscriptionPersonal.TimeWindows)
+	if err != nil {
+		return nil, err
+	}
+	if err := insertTimeWindows(ctx, tx, req.SubscriptionPersonal.Id, req.SubscriptionPersonal.Version, req.SubscriptionPersonal.TimeWindows); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	req.SubscriptionPersonal.CreatedAt = createdAt.Format(time.RFC3339)
 	req.SubscriptionPersonal.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -72,6 +112,9 @@ func (r *SubscriptionPersonalRepo) CreateSubscriptionPersonal(ctx context.Contex
 }
 
 func (r *SubscriptionPersonalRepo) GetSubscriptionPersonal(ctx context.Context, req *booking.GetSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionPersonalRepo.GetSubscriptionPersonal")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -81,6 +124,7 @@ func (r *SubscriptionPersonalRepo) GetSubscriptionPersonal(ctx context.Context,
 			price,
 			duration,
 			count,
+			version,
 			created_at,
 			updated_at
 		FROM subscription_personal
@@ -101,6 +145,7 @@ func (r *SubscriptionPersonalRepo) GetSubscriptionPersonal(ctx context.Context,
 		&subscription.Price,
 		&subscription.Duration,
 		&subscription.Count,
+		&subscription.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -115,10 +160,56 @@ func (r *SubscriptionPersonalRepo) GetSubscriptionPersonal(ctx context.Context,
 	subscription.CreatedAt = createdAt.Format(time.RFC3339)
 	subscription.UpdatedAt = updatedAt.Format(time.RFC3339)
 
+	subscription.TimeWindows, err = listTimeWindows(ctx, r.db, subscription.Id, subscription.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	return &subscription, nil
 }
 
 func (r *SubscriptionPersonalRepo) UpdateSubscriptionPersonal(ctx context.Context, req *booking.UpdateSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionPersonalRepo.UpdateSubscriptionPersonal")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Time windows are part of the version bookings are sold under. They are
+	// only replaced when the request asks for it, and a change to them starts
+	// a new version so existing bookings keep their hours.
+	var current int32
+	err = tx.QueryRow(ctx, `SELECT version FROM subscription_personal WHERE id = $1 FOR UPDATE`, req.SubscriptionPersonal.Id).Scan(&current)
+	if err != nil {
+		return nil, err
+	}
+	windows, err := listTimeWindows(ctx, tx, req.SubscriptionPersonal.Id, current)
+	if err != nil {
+		return nil, err
+	}
+	var windowsChanged bool
+	if req.SetTimeWindows {
+		requested, err := normalizeTimeWindows(req.SubscriptionPersonal.TimeWindows)
+		if err != nil {
+			return nil, err
+		}
+		windowsChanged = !sameTimeWindows(windows, requested)
+		windows = requested
+	}
+
+	version, err := nextSubscriptionVersion(ctx, tx, subscriptionTypePersonal, req.SubscriptionPersonal.Id,
+		req.SubscriptionPersonal.Price,
+		req.SubscriptionPersonal.Duration,
+		req.SubscriptionPersonal.Count,
+		windowsChanged,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 		UPDATE subscription_personal
 		SET
@@ -128,9 +219,10 @@ func (r *SubscriptionPersonalRepo) UpdateSubscriptionPersonal(ctx context.Contex
 			price = $4,
 			duration = $5,
 			count = $6,
+			version = $7,
 			updated_at = NOW()
-		WHERE id = $7
-		RETURNING id, gym_id, type, description, price, duration, count, created_at, updated_at
+		WHERE id = $8
+		RETURNING id, gym_id, type, description, price, duration, count, version, created_at, updated_at
 	`
 
 	var (
@@ -138,13 +230,14 @@ func (r *SubscriptionPersonalRepo) UpdateSubscriptionPersonal(ctx context.Contex
 		updatedAt time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		req.SubscriptionPersonal.GymId,
 		req.SubscriptionPersonal.Type,
 		req.SubscriptionPersonal.Description,
 		req.SubscriptionPersonal.Price,
 		req.SubscriptionPersonal.Duration,
 		req.SubscriptionPersonal.Count,
+		version,
 		req.SubscriptionPersonal.Id,
 	).Scan(
 		&req.SubscriptionPersonal.Id,
@@ -154,6 +247,7 @@ func (r *SubscriptionPersonalRepo) UpdateSubscriptionPersonal(ctx context.Contex
 		&req.SubscriptionPersonal.Price,
 		&req.SubscriptionPersonal.Duration,
 		&req.SubscriptionPersonal.Count,
+		&req.SubscriptionPersonal.Version,
 		&createdAt,
 		&updatedAt,
 	)
@@ -165,6 +259,17 @@ func (r *SubscriptionPersonalRepo) UpdateSubscriptionPersonal(ctx context.Contex
 		return nil, err
 	}
 
+	if version != current {
+		if err := insertTimeWindows(ctx, tx, req.SubscriptionPersonal.Id, version, windows); err != nil {
+			return nil, err
+		}
+	}
+	req.SubscriptionPersonal.TimeWindows = windows
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	req.SubscriptionPersonal.CreatedAt = createdAt.Format(time.RFC3339)
 	req.SubscriptionPersonal.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -172,12 +277,21 @@ func (r *SubscriptionPersonalRepo) UpdateSubscriptionPersonal(ctx context.Contex
 }
 
 func (r *SubscriptionPersonalRepo) DeleteSubscriptionPersonal(ctx context.Context, req *booking.DeleteSubscriptionPersonalRequest) error {
+	ctx, span := tracing.Start(ctx, "SubscriptionPersonalRepo.DeleteSubscriptionPersonal")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		DELETE FROM subscription_personal
 		WHERE id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, req.Id)
+	result, err := tx.Exec(ctx, query, req.Id)
 	if err != nil {
 		return err
 	}
@@ -186,10 +300,17 @@ func (r *SubscriptionPersonalRepo) DeleteSubscriptionPersonal(ctx context.Contex
 		return pgx.ErrNoRows
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (r *SubscriptionPersonalRepo) ListSubscriptionPersonal(ctx context.Context, req *booking.ListSubscriptionPersonalRequest) (*booking.ListSubscriptionPersonalResponse, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionPersonalRepo.ListSubscriptionPersonal")
+	defer span.End()
+
 	query := `
 		SELECT
 			id,
@@ -199,6 +320,7 @@ func (r *SubscriptionPersonalRepo) ListSubscriptionPersonal(ctx context.Context,
 			price,
 			duration,
 			count,
+			version,
 			created_at,
 			updated_at
 		FROM subscription_personal
@@ -228,6 +350,7 @@ func (r *SubscriptionPersonalRepo) ListSubscriptionPersonal(ctx context.Context,
 			&subscription.Price,
 			&subscription.Duration,
 			&subscription.Count,
+			&subscription.Version,
 			&createdAt,
 			&updatedAt,
 		)
@@ -242,5 +365,114 @@ func (r *SubscriptionPersonalRepo) ListSubscriptionPersonal(ctx context.Context,
 		subscriptions = append(subscriptions, &subscription)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for _, subscription := range subscriptions {
+		subscription.TimeWindows, err = listTimeWindows(ctx, r.db, subscription.Id, subscription.Version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &booking.ListSubscriptionPersonalResponse{SubscriptionPersonal: subscriptions}, nil
 }
+
+func (r *SubscriptionPersonalRepo) ListSubscriptionPersonalVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error) {
+	ctx, span := tracing.Start(ctx, "SubscriptionPersonalRepo.ListSubscriptionPersonalVersions")
+	defer span.End()
+
+	return listSubscriptionVersions(ctx, r.db, subscriptionTypePersonal, req.SubscriptionId)
+}
+
+// normalizeTimeWindows validates weekly time windows and returns them as
+// HH:MM, ordered by weekday and start time.
+func normalizeTimeWindows(windows []*booking.TimeWindow) ([]*booking.TimeWindow, error) {
+	var normalized []*booking.TimeWindow
+	for _, window := range windows {
+		if window.Weekday < 0 || window.Weekday > 6 {
+			return nil, storage.Errorf(storage.ErrInvalidArgument, "invalid weekday %d", window.Weekday)
+		}
+		start, err := time.Parse("15:04", window.StartTime)
+		if err != nil {
+			return nil, storage.Errorf(storage.ErrInvalidArgument, "invalid start time %q", window.StartTime)
+		}
+		end, err := time.Parse("15:04", window.EndTime)
+		if err != nil {
+			return nil, storage.Errorf(storage.ErrInvalidArgument, "invalid end time %q", window.EndTime)
+		}
+		if !start.Before(end) {
+			return nil, storage.Errorf(storage.ErrInvalidArgument, "time window must start before it ends")
+		}
+
+		normalized = append(normalized, &booking.TimeWindow{
+			Weekday:   window.Weekday,
+			StartTime: start.Format("15:04"),
+			EndTime:   end.Format("15:04"),
+		})
+	}
+
+	sort.Slice(normalized, func(i, j int) bool {
+		if normalized[i].Weekday != normalized[j].Weekday {
+			return normalized[i].Weekday < normalized[j].Weekday
+		}
+		return normalized[i].StartTime < normalized[j].StartTime
+	})
+	return normalized, nil
+}
+
+// sameTimeWindows reports whether two normalized sets of windows are equal.
+func sameTimeWindows(a, b []*booking.TimeWindow) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Weekday != b[i].Weekday || a[i].StartTime != b[i].StartTime || a[i].EndTime != b[i].EndTime {
+			return false
+		}
+	}
+	return true
+}
+
+// insertTimeWindows records the weekly time windows of a personal plan
+// version. The windows must be normalized.
+func insertTimeWindows(ctx context.Context, q querier, subscriptionID string, version int32, windows []*booking.TimeWindow) error {
+	for _, window := range windows {
+		_, err := q.Exec(ctx, `
+			INSERT INTO subscription_time_windows (subscription_id, version, weekday, start_time, end_time)
+			VALUES ($1, $2, $3, $4::time, $5::time)
+		`, subscriptionID, version, window.Weekday, window.StartTime, window.EndTime)
+		if err != nil {
+			return fmt.Errorf("error adding time window: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listTimeWindows returns the weekly time windows of a personal plan version.
+func listTimeWindows(ctx context.Context, q querier, subscriptionID string, version int32) ([]*booking.TimeWindow, error) {
+	rows, err := q.Query(ctx, `
+		SELECT weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+		FROM subscription_time_windows
+		WHERE subscription_id = $1 AND version = $2
+		ORDER BY weekday, start_time
+	`, subscriptionID, version)
+	if err != nil {
+		return nil, fmt.Errorf("error listing time windows: %w", err)
+	}
+	defer rows.Close()
+
+	var windows []*booking.TimeWindow
+	for rows.Next() {
+		var window booking.TimeWindow
+		if err := rows.Scan(&window.Weekday, &window.StartTime, &window.EndTime); err != nil {
+			return nil, fmt.Errorf("error scanning time window: %w", err)
+		}
+		windows = append(windows, &window)
+	}
+
+	return windows, rows.Err()
+}
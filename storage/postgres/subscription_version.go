@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Subscription types as stored in subscription_versions.subscription_type.
+const (
+	subscriptionTypePersonal = "personal"
+	subscriptionTypeGroup    = "group"
+	subscriptionTypeCoach    = "coach"
+)
+
+// insertSubscriptionVersion records an immutable pricing snapshot for a plan.
+func insertSubscriptionVersion(ctx context.Context, tx pgx.Tx, subscriptionType, subscriptionID string, version, price, duration, count int32) error {
+	query := `
+		INSERT INTO subscription_versions (
+			subscription_id,
+			subscription_type,
+			version,
+			price,
+			duration,
+			count,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	`
+	_, err := tx.Exec(ctx, query, subscriptionID, subscriptionType, version, price, duration, count)
+	if err != nil {
+		return fmt.Errorf("error creating subscription version: %w", err)
+	}
+	return nil
+}
+
+// nextSubscriptionVersion locks the plan row and returns the version it should
+// carry after an update. When price, duration or count differ from the current
+// version, or changed reports another change that bookings are sold under, a
+// new snapshot is recorded; otherwise the current version is kept.
+func nextSubscriptionVersion(ctx context.Context, tx pgx.Tx, subscriptionType, subscriptionID string, price, duration, count int32, changed bool) (int32, error) {
+	var version int32
+	query := fmt.Sprintf(`SELECT version FROM subscription_%s WHERE id = $1 FOR UPDATE`, subscriptionType)
+	if err := tx.QueryRow(ctx, query, subscriptionID).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	var curPrice, curDuration, curCount int32
+	err := tx.QueryRow(ctx, `
+		SELECT price, duration, count
+		FROM subscription_versions
+		WHERE subscription_type = $1 AND subscription_id = $2 AND version = $3
+	`, subscriptionType, subscriptionID, version).Scan(&curPrice, &curDuration, &curCount)
+	if err != nil && err != pgx.ErrNoRows {
+		return 0, fmt.Errorf("error getting subscription version: %w", err)
+	}
+
+	if err == nil && !changed && curPrice == price && curDuration == duration && curCount == count {
+		return version, nil
+	}
+
+	version++
+	if err := insertSubscriptionVersion(ctx, tx, subscriptionType, subscriptionID, version, price, duration, count); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// listSubscriptionVersions returns every version of a plan, oldest first.
+func listSubscriptionVersions(ctx context.Context, db *pgxpool.Pool, subscriptionType, subscriptionID string) (*booking.ListSubscriptionVersionsResponse, error) {
+	query := `
+		SELECT
+			subscription_id,
+			version,
+			price,
+			duration,
+			count,
+			created_at
+		FROM subscription_versions
+		WHERE subscription_type = $1 AND subscription_id = $2
+		ORDER BY version
+	`
+
+	rows, err := db.Query(ctx, query, subscriptionType, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []*booking.SubscriptionVersion
+
+	for rows.Next() {
+		var (
+			version   booking.SubscriptionVersion
+			createdAt time.Time
+		)
+
+		err := rows.Scan(
+			&version.SubscriptionId,
+			&version.Version,
+			&version.Price,
+			&version.Duration,
+			&version.Count,
+			&createdAt,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		version.CreatedAt = createdAt.Format(time.RFC3339)
+
+		versions = append(versions, &version)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &booking.ListSubscriptionVersionsResponse{Versions: versions}, nil
+}
@@ -0,0 +1,546 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/helper"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/tracing"
+	"github.com/Athlevo/Booking-Athlevo/webhook"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Webhook delivery statuses.
+const (
+	webhookStatusPending   = "pending"
+	webhookStatusDelivered = "delivered"
+	webhookStatusDead      = "dead"
+)
+
+// WebhookRepo implements the WebhookRepoI interface, and webhook.Store for
+// the dispatcher.
+type WebhookRepo struct {
+	db           *pgxpool.Pool
+	allowPrivate bool
+	logger       *slog.Logger
+}
+
+// NewWebhookRepo creates a new WebhookRepo. Endpoints must be https urls on
+// public hosts unless cfg.WebhookAllowPrivate is set.
+func NewWebhookRepo(db *pgxpool.Pool, cfg config.Config, logger *slog.Logger) *WebhookRepo {
+	return &WebhookRepo{
+		db:           db,
+		allowPrivate: cfg.WebhookAllowPrivate,
+		logger:       logger,
+	}
+}
+
+const webhookEndpointColumns = `id, gym_id, url, event_types, COALESCE(description, ''), active, created_at, updated_at`
+
+// CreateWebhookEndpoint registers an active endpoint for a gym's events and
+// generates its signing secret. The secret is only returned here.
+func (r *WebhookRepo) CreateWebhookEndpoint(ctx context.Context, req *booking.CreateWebhookEndpointRequest) (*booking.WebhookEndpoint, error) {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.CreateWebhookEndpoint")
+	defer span.End()
+
+	endpoint := req.WebhookEndpoint
+	if err := validateWebhookEndpoint(endpoint, r.allowPrivate); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := fmt.Sprintf(`
+		INSERT INTO webhook_endpoints (
+			id,
+			gym_id,
+			url,
+			secret,
+			event_types,
+			description,
+			active,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), TRUE, NOW(), NOW())
+		RETURNING %s
+	`, webhookEndpointColumns)
+
+	created, err := scanWebhookEndpoint(tx.QueryRow(ctx, query,
+		uuid.New().String(),
+		endpoint.GymId,
+		endpoint.Url,
+		secret,
+		webhookEventTypes(endpoint.EventTypes),
+		endpoint.Description,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	created.Secret = secret
+
+	return created, nil
+}
+
+// UpdateWebhookEndpoint changes an endpoint's url, event types, description
+// and active flag. The secret stays the same.
+func (r *WebhookRepo) UpdateWebhookEndpoint(ctx context.Context, req *booking.UpdateWebhookEndpointRequest) (*booking.WebhookEndpoint, error) {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.UpdateWebhookEndpoint")
+	defer span.End()
+
+	endpoint := req.WebhookEndpoint
+	if err := validateWebhookEndpoint(endpoint, r.allowPrivate); err != nil {
+		return nil, err
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := fmt.Sprintf(`
+		UPDATE webhook_endpoints
+		SET
+			url = $2,
+			event_types = $3,
+			description = NULLIF($4, ''),
+			active = $5,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING %s
+	`, webhookEndpointColumns)
+
+	updated, err := scanWebhookEndpoint(tx.QueryRow(ctx, query,
+		endpoint.Id,
+		endpoint.Url,
+		webhookEventTypes(endpoint.EventTypes),
+		endpoint.Description,
+		endpoint.Active,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint with its deliveries.
+func (r *WebhookRepo) DeleteWebhookEndpoint(ctx context.Context, req *booking.DeleteWebhookEndpointRequest) error {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.DeleteWebhookEndpoint")
+	defer span.End()
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM webhook_endpoints WHERE id = $1`, req.Id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListWebhookEndpoints retrieves a gym's endpoints, without their secrets.
+func (r *WebhookRepo) ListWebhookEndpoints(ctx context.Context, req *booking.ListWebhookEndpointsRequest) (*booking.ListWebhookEndpointsResponse, error) {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.ListWebhookEndpoints")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM webhook_endpoints
+		WHERE gym_id = $1
+		ORDER BY created_at
+	`, webhookEndpointColumns)
+
+	rows, err := r.db.Query(ctx, query, req.GymId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var endpoints []*booking.WebhookEndpoint
+
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return &booking.ListWebhookEndpointsResponse{WebhookEndpoints: endpoints}, nil
+}
+
+// ListWebhookDeliveries retrieves deliveries by gym, endpoint and status,
+// newest first. Listing the dead deliveries shows what needs replaying.
+func (r *WebhookRepo) ListWebhookDeliveries(ctx context.Context, req *booking.ListWebhookDeliveriesRequest) (*booking.ListWebhookDeliveriesResponse, error) {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.ListWebhookDeliveries")
+	defer span.End()
+
+	var args []interface{}
+	count := 1
+	query := `
+		SELECT
+			d.id,
+			d.endpoint_id,
+			d.event_id,
+			d.event_type,
+			d.status,
+			d.attempts,
+			COALESCE(d.last_status_code, 0),
+			COALESCE(d.last_error, ''),
+			d.next_attempt_at,
+			d.created_at,
+			d.delivered_at
+		FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		WHERE 1=1
+	`
+
+	if req.GymId != "" {
+		query += fmt.Sprintf(" AND e.gym_id = $%d", count)
+		args = append(args, req.GymId)
+		count++
+	}
+
+	if req.EndpointId != "" {
+		query += fmt.Sprintf(" AND d.endpoint_id = $%d", count)
+		args = append(args, req.EndpointId)
+		count++
+	}
+
+	if req.Status != "" {
+		query += fmt.Sprintf(" AND d.status = $%d", count)
+		args = append(args, req.Status)
+		count++
+	}
+
+	page, limit := req.Page, req.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	query += fmt.Sprintf(" ORDER BY d.created_at DESC, d.id LIMIT $%d OFFSET $%d", count, count+1)
+	args = append(args, limit, (page-1)*limit)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*booking.WebhookDelivery
+
+	for rows.Next() {
+		var (
+			delivery      booking.WebhookDelivery
+			nextAttemptAt time.Time
+			createdAt     time.Time
+			deliveredAt   sql.NullTime
+		)
+		err := rows.Scan(
+			&delivery.Id,
+			&delivery.EndpointId,
+			&delivery.EventId,
+			&delivery.EventType,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.LastStatusCode,
+			&delivery.LastError,
+			&nextAttemptAt,
+			&createdAt,
+			&deliveredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if delivery.Status == webhookStatusPending {
+			delivery.NextAttemptAt = nextAttemptAt.Format(time.RFC3339)
+		}
+		delivery.CreatedAt = createdAt.Format(time.RFC3339)
+		delivery.DeliveredAt = helper.DateToString(deliveredAt)
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return &booking.ListWebhookDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+// ReplayWebhookDeliveries queues deliveries to be sent again right away with
+// a fresh set of retries: the given ones, or every dead delivery of an
+// endpoint.
+func (r *WebhookRepo) ReplayWebhookDeliveries(ctx context.Context, req *booking.ReplayWebhookDeliveriesRequest) (*booking.ReplayWebhookDeliveriesResponse, error) {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.ReplayWebhookDeliveries")
+	defer span.End()
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW(), delivered_at = NULL
+	`
+
+	var arg any
+	switch {
+	case len(req.Ids) > 0:
+		query += ` WHERE id = ANY($1::uuid[])`
+		arg = req.Ids
+	case req.EndpointId != "":
+		query += ` WHERE endpoint_id = $1 AND status = 'dead'`
+		arg = req.EndpointId
+	default:
+		return nil, storage.Errorf(storage.ErrInvalidArgument, "ids or endpoint_id is required")
+	}
+
+	tx, err := beginAudited(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, query, arg)
+	if err != nil {
+		return nil, fmt.Errorf("error replaying webhook deliveries: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &booking.ReplayWebhookDeliveriesResponse{Replayed: int32(result.RowsAffected())}, nil
+}
+
+// ClaimDeliveries implements webhook.Store.
+func (r *WebhookRepo) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.ClaimDeliveries")
+	defer span.End()
+
+	rows, err := r.db.Query(ctx, `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $2)
+		FROM webhook_endpoints e
+		WHERE e.id = d.endpoint_id AND d.id IN (
+			SELECT due.id
+			FROM webhook_deliveries due
+			JOIN webhook_endpoints active ON active.id = due.endpoint_id
+			WHERE due.status = 'pending' AND due.next_attempt_at <= NOW() AND active.active
+			ORDER BY due.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF due SKIP LOCKED
+		)
+		RETURNING d.id, e.url, e.secret, d.event_type, d.body, d.attempts
+	`, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*webhook.Delivery
+	for rows.Next() {
+		var delivery webhook.Delivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.URL,
+			&delivery.Secret,
+			&delivery.EventType,
+			&delivery.Body,
+			&delivery.Attempts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error claiming webhook deliveries: %w", err)
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// RecordAttempt implements webhook.Store.
+func (r *WebhookRepo) RecordAttempt(ctx context.Context, id string, attempt webhook.Attempt) error {
+	ctx, span := tracing.Start(ctx, "WebhookRepo.RecordAttempt")
+	defer span.End()
+
+	status := webhookStatusPending
+	switch {
+	case attempt.Error == "":
+		status = webhookStatusDelivered
+	case attempt.Dead:
+		status = webhookStatusDead
+	}
+
+	var nextAttemptAt any
+	if !attempt.NextAttemptAt.IsZero() {
+		nextAttemptAt = attempt.NextAttemptAt
+	}
+
+	_, err := r.db.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET
+			attempts = attempts + 1,
+			status = $2,
+			last_status_code = NULLIF($3, 0),
+			last_error = NULLIF($4, ''),
+			next_attempt_at = COALESCE($5::timestamptz, next_attempt_at),
+			delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() END
+		WHERE id = $1
+	`, id, status, attempt.StatusCode, attempt.Error, nextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("error recording webhook attempt: %w", err)
+	}
+	return nil
+}
+
+// enqueueWebhookDeliveries queues an event for every active endpoint of its
+// gym subscribed to its type.
+func enqueueWebhookDeliveries(ctx context.Context, q querier, envelope *booking.EventEnvelope) error {
+	if envelope.GymId == "" {
+		return nil
+	}
+
+	var subscribed bool
+	err := q.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM webhook_endpoints
+			WHERE gym_id = $1 AND active AND (cardinality(event_types) = 0 OR $2 = ANY(event_types))
+		)
+	`, envelope.GymId, envelope.Type).Scan(&subscribed)
+	if err != nil {
+		return fmt.Errorf("error finding webhooks: %w", err)
+	}
+	if !subscribed {
+		return nil
+	}
+
+	body, err := webhook.Body(envelope)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.Exec(ctx, `
+		INSERT INTO webhook_deliveries (id, endpoint_id, event_id, event_type, body)
+		SELECT gen_random_uuid(), id, $3, $2, $4
+		FROM webhook_endpoints
+		WHERE gym_id = $1 AND active AND (cardinality(event_types) = 0 OR $2 = ANY(event_types))
+	`, envelope.GymId, envelope.Type, envelope.Id, body)
+	if err != nil {
+		return fmt.Errorf("error queueing webhooks: %w", err)
+	}
+	return nil
+}
+
+// validateWebhookEndpoint checks the url and event types of an endpoint.
+// allowPrivate also accepts http urls and non-public hosts.
+func validateWebhookEndpoint(endpoint *booking.WebhookEndpoint, allowPrivate bool) error {
+	if err := webhook.CheckURL(endpoint.Url, allowPrivate); err != nil {
+		return storage.Errorf(storage.ErrInvalidArgument, "%v", err)
+	}
+
+	for _, eventType := range endpoint.EventTypes {
+		if !events.IsType(eventType) {
+			return storage.Errorf(storage.ErrInvalidArgument, "unknown event type %q", eventType)
+		}
+	}
+
+	return nil
+}
+
+// webhookEventTypes stores an empty filter as an empty array, not NULL.
+func webhookEventTypes(eventTypes []string) []string {
+	if eventTypes == nil {
+		return []string{}
+	}
+	return eventTypes
+}
+
+// newWebhookSecret generates a random signing secret.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// scanWebhookEndpoint scans a row selected with webhookEndpointColumns.
+func scanWebhookEndpoint(row pgx.Row) (*booking.WebhookEndpoint, error) {
+	var (
+		endpoint  booking.WebhookEndpoint
+		createdAt time.Time
+		updatedAt time.Time
+	)
+
+	err := row.Scan(
+		&endpoint.Id,
+		&endpoint.GymId,
+		&endpoint.Url,
+		&endpoint.EventTypes,
+		&endpoint.Description,
+		&endpoint.Active,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.CreatedAt = createdAt.Format(time.RFC3339)
+	endpoint.UpdatedAt = updatedAt.Format(time.RFC3339)
+
+	return &endpoint, nil
+}
@@ -19,6 +19,28 @@ type StorageI interface {
 	Access() AccessRepoI
 
 	AccessBeta() AccessRepoBetaI
+
+	Pass() PassRepoI
+
+	BookingMember() BookingMemberRepoI
+	BookingTransfer() BookingTransferRepoI
+
+	Bundle() BundleRepoI
+
+	GenderOverride() GenderOverrideRepoI
+
+	Occupancy() OccupancyRepoI
+
+	OfflineAccess() OfflineAccessRepoI
+
+	Audit() AuditRepoI
+
+	Webhook() WebhookRepoI
+
+	// Ping checks that the database can be reached.
+	Ping(ctx context.Context) error
+	// Close closes the database connection.
+	Close(ctx context.Context) error
 }
 
 // BookingPersonalRepoI defines methods for interacting with personal bookings.
@@ -28,6 +50,7 @@ type BookingPersonalRepoI interface {
 	UpdateBookingPersonal(ctx context.Context, req *booking.UpdateBookingPersonalRequest) (*booking.BookingPersonal, error)
 	DeleteBookingPersonal(ctx context.Context, req *booking.DeleteBookingPersonalRequest) error
 	ListBookingPersonal(ctx context.Context, req *booking.ListBookingPersonalRequest) (*booking.ListBookingPersonalResponse, error)
+	ChangePlan(ctx context.Context, req *booking.ChangePlanRequest) (*booking.ChangePlanResponse, error)
 }
 
 // BookingGroupRepoI defines methods for interacting with group bookings.
@@ -55,6 +78,7 @@ type SubscriptionPersonalRepoI interface {
 	UpdateSubscriptionPersonal(ctx context.Context, req *booking.UpdateSubscriptionPersonalRequest) (*booking.SubscriptionPersonal, error)
 	DeleteSubscriptionPersonal(ctx context.Context, req *booking.DeleteSubscriptionPersonalRequest) error
 	ListSubscriptionPersonal(ctx context.Context, req *booking.ListSubscriptionPersonalRequest) (*booking.ListSubscriptionPersonalResponse, error)
+	ListSubscriptionPersonalVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error)
 }
 
 // SubscriptionGroupRepoI defines methods for interacting with group subscriptions.
@@ -64,6 +88,7 @@ type SubscriptionGroupRepoI interface {
 	UpdateSubscriptionGroup(ctx context.Context, req *booking.UpdateSubscriptionGroupRequest) (*booking.SubscriptionGroup, error)
 	DeleteSubscriptionGroup(ctx context.Context, req *booking.DeleteSubscriptionGroupRequest) error
 	ListSubscriptionGroup(ctx context.Context, req *booking.ListSubscriptionGroupRequest) (*booking.ListSubscriptionGroupResponse, error)
+	ListSubscriptionGroupVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error)
 }
 
 // SubscriptionCoachRepoI defines methods for interacting with coach subscriptions.
@@ -73,6 +98,7 @@ type SubscriptionCoachRepoI interface {
 	UpdateSubscriptionCoach(ctx context.Context, req *booking.UpdateSubscriptionCoachRequest) (*booking.SubscriptionCoach, error)
 	DeleteSubscriptionCoach(ctx context.Context, req *booking.DeleteSubscriptionCoachRequest) error
 	ListSubscriptionCoach(ctx context.Context, req *booking.ListSubscriptionCoachRequest) (*booking.ListSubscriptionCoachResponse, error)
+	ListSubscriptionCoachVersions(ctx context.Context, req *booking.ListSubscriptionVersionsRequest) (*booking.ListSubscriptionVersionsResponse, error)
 }
 
 // AccessRepoI defines methods for interacting with access records.
@@ -85,8 +111,98 @@ type AccessRepoI interface {
 
 	CreateAccessCoach(ctx context.Context, req *booking.CreateAccessCoachRequest) (*booking.AccessCoach, error)
 	ListAccessCoach(ctx context.Context, req *booking.ListAccessCoachRequest) (*booking.ListAccessCoachResponse, error)
+
+	ReplayAccessEvents(ctx context.Context, req *booking.StreamAccessEventsRequest, afterID int64, limit int) ([]*booking.AccessEvent, error)
+	WatchAccessEvents(ctx context.Context, req *booking.StreamAccessEventsRequest) <-chan *booking.AccessEvent
+	ListAccessHistory(ctx context.Context, req *booking.ListAccessHistoryRequest) (*booking.ListAccessHistoryResponse, error)
+	ListSuspiciousAccess(ctx context.Context, req *booking.ListSuspiciousAccessRequest) (*booking.ListSuspiciousAccessResponse, error)
 }
 
 type AccessRepoBetaI interface {
 	CheckUserAccess(ctx context.Context, req *booking.AccessBetaPersonalRequest) (*booking.AccessBetaPersonalResponse, error)
+	CheckUserExit(ctx context.Context, req *booking.AccessBetaPersonalRequest) (*booking.AccessBetaPersonalResponse, error)
+
+	IssueCheckInToken(ctx context.Context, req *booking.IssueCheckInTokenRequest) (*booking.CheckInToken, error)
+	CheckInWithToken(ctx context.Context, req *booking.CheckInWithTokenRequest) (*booking.AccessBetaPersonalResponse, error)
+
+	CheckInWithFace(ctx context.Context, req *booking.FaceCheckInRequest) (*booking.AccessBetaPersonalResponse, error)
+}
+
+// FaceResolver maps a face identifier reported by a recognition device to a
+// user ID. It returns an empty string when the face is not known.
+type FaceResolver interface {
+	ResolveFace(ctx context.Context, faceID string) (string, error)
+}
+
+// PassRepoI defines methods for interacting with trial and guest passes.
+type PassRepoI interface {
+	IssuePass(ctx context.Context, req *booking.IssuePassRequest) (*booking.Pass, error)
+	GetPass(ctx context.Context, req *booking.GetPassRequest) (*booking.Pass, error)
+	ListPasses(ctx context.Context, req *booking.ListPassesRequest) (*booking.ListPassesResponse, error)
+	RevokePass(ctx context.Context, req *booking.RevokePassRequest) error
+	GetTrialConversionReport(ctx context.Context, req *booking.TrialConversionReportRequest) (*booking.TrialConversionReport, error)
+}
+
+// BookingMemberRepoI defines methods for managing the members of shared bookings.
+type BookingMemberRepoI interface {
+	AddBookingMember(ctx context.Context, req *booking.AddBookingMemberRequest) (*booking.BookingMember, error)
+	RemoveBookingMember(ctx context.Context, req *booking.RemoveBookingMemberRequest) error
+	ListBookingMembers(ctx context.Context, req *booking.ListBookingMembersRequest) (*booking.ListBookingMembersResponse, error)
+}
+
+// BookingTransferRepoI defines methods for transferring bookings between members.
+type BookingTransferRepoI interface {
+	SetTransferRule(ctx context.Context, req *booking.SetTransferRuleRequest) (*booking.TransferRule, error)
+	GetTransferRule(ctx context.Context, req *booking.GetTransferRuleRequest) (*booking.TransferRule, error)
+	TransferBooking(ctx context.Context, req *booking.TransferBookingRequest) (*booking.BookingTransfer, error)
+	ListBookingTransfers(ctx context.Context, req *booking.ListBookingTransfersRequest) (*booking.ListBookingTransfersResponse, error)
+}
+
+// BundleRepoI defines methods for selling several plans as one bundle.
+type BundleRepoI interface {
+	CreateBundle(ctx context.Context, req *booking.CreateBundleRequest) (*booking.Bundle, error)
+	GetBundle(ctx context.Context, req *booking.GetBundleRequest) (*booking.Bundle, error)
+	DeleteBundle(ctx context.Context, req *booking.DeleteBundleRequest) error
+	ListBundles(ctx context.Context, req *booking.ListBundlesRequest) (*booking.ListBundlesResponse, error)
+	PurchaseBundle(ctx context.Context, req *booking.PurchaseBundleRequest) (*booking.BundlePurchase, error)
+	GetBundlePurchase(ctx context.Context, req *booking.GetBundlePurchaseRequest) (*booking.BundlePurchase, error)
+	ListBundlePurchases(ctx context.Context, req *booking.ListBundlePurchasesRequest) (*booking.ListBundlePurchasesResponse, error)
+}
+
+// GenderOverrideRepoI defines methods for managing exceptions to gender-restricted halls.
+type GenderOverrideRepoI interface {
+	GrantGenderOverride(ctx context.Context, req *booking.GrantGenderOverrideRequest) (*booking.GenderOverride, error)
+	RevokeGenderOverride(ctx context.Context, req *booking.RevokeGenderOverrideRequest) error
+	ListGenderOverrides(ctx context.Context, req *booking.ListGenderOverridesRequest) (*booking.ListGenderOverridesResponse, error)
+	SetMemberGender(ctx context.Context, req *booking.SetMemberGenderRequest) (*booking.MemberGender, error)
+}
+
+// OccupancyRepoI defines methods for tracking how many members are inside a hall.
+type OccupancyRepoI interface {
+	GetOccupancy(ctx context.Context, req *booking.GetOccupancyRequest) (*booking.Occupancy, error)
+	SetMaxOccupancy(ctx context.Context, req *booking.SetMaxOccupancyRequest) (*booking.Occupancy, error)
+	WatchOccupancy(ctx context.Context, gymID string) <-chan *booking.Occupancy
+}
+
+// OfflineAccessRepoI defines methods for turnstile controllers working offline.
+type OfflineAccessRepoI interface {
+	ExportOfflineAccess(ctx context.Context, req *booking.ExportOfflineAccessRequest) (*booking.OfflineAccessSnapshot, error)
+	ImportOfflineEntries(ctx context.Context, req *booking.ImportOfflineEntriesRequest) (*booking.ImportOfflineEntriesResponse, error)
+	RegisterOfflineDevice(ctx context.Context, req *booking.RegisterOfflineDeviceRequest) (*booking.OfflineDevice, error)
+	RevokeOfflineDevice(ctx context.Context, req *booking.RevokeOfflineDeviceRequest) error
+}
+
+// AuditRepoI defines methods for reading the change history of entities.
+type AuditRepoI interface {
+	GetEntityHistory(ctx context.Context, req *booking.GetEntityHistoryRequest) (*booking.GetEntityHistoryResponse, error)
+}
+
+// WebhookRepoI defines methods for managing gym webhooks and their deliveries.
+type WebhookRepoI interface {
+	CreateWebhookEndpoint(ctx context.Context, req *booking.CreateWebhookEndpointRequest) (*booking.WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, req *booking.UpdateWebhookEndpointRequest) (*booking.WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, req *booking.DeleteWebhookEndpointRequest) error
+	ListWebhookEndpoints(ctx context.Context, req *booking.ListWebhookEndpointsRequest) (*booking.ListWebhookEndpointsResponse, error)
+	ListWebhookDeliveries(ctx context.Context, req *booking.ListWebhookDeliveriesRequest) (*booking.ListWebhookDeliveriesResponse, error)
+	ReplayWebhookDeliveries(ctx context.Context, req *booking.ReplayWebhookDeliveriesRequest) (*booking.ReplayWebhookDeliveriesResponse, error)
 }
@@ -0,0 +1,345 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessBetaRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	events := pubsub.NewBroker[*booking.AccessEvent](16)
+	accessRepo := postgres.NewAccessRepo(db, events, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	userID := uuid.New().String()
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "10 visits",
+			Price:    100,
+			Duration: 30,
+			Count:    10,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         userID,
+			SubscriptionId: createdSubscription.Id,
+			Payment:        100,
+			StartDate:      time.Now().Add(-time.Minute).Format(time.RFC3339),
+			Count:          1,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+	req := &booking.AccessBetaPersonalRequest{
+		UserId:      userID,
+		SportHallId: gymID,
+	}
+
+	t.Run("DuplicateScanCountsOneVisit", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout:      time.Hour,
+			AccessDuplicateScanWindow: time.Minute,
+		}, pubsub.NewBroker[*booking.Occupancy](16), pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
+
+		for i := 0; i < 2; i++ {
+			resp, err := accessBetaRepo.CheckUserAccess(context.Background(), req)
+			assert.NoError(t, err)
+			assert.Equal(t, "granted", resp.Message)
+		}
+
+		visits, err := accessRepo.ListAccessPersonal(context.Background(), &booking.ListAccessPersonalRequest{
+			BookingPersonalId: createdBooking.Id,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, visits.AccessPersonal, 1)
+
+		resp, err := accessBetaRepo.CheckUserExit(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+	})
+
+	t.Run("ReentryBlockedUntilExit", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+		}, pubsub.NewBroker[*booking.Occupancy](16), pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
+
+		resp, err := accessBetaRepo.CheckUserAccess(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+
+		resp, err = accessBetaRepo.CheckUserAccess(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "denied", resp.Message)
+		assert.Equal(t, "already checked in, exit not recorded", resp.Reason)
+
+		denials, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{
+			UserId: userID,
+			Result: "denied",
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, denials.Events, 1) {
+			assert.Equal(t, "already checked in, exit not recorded", denials.Events[0].Reason)
+		}
+
+		_, err = accessBetaRepo.CheckUserExit(context.Background(), req)
+		assert.NoError(t, err)
+
+		resp, err = accessBetaRepo.CheckUserAccess(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+	})
+
+	t.Run("ExitWithoutEntryDenied", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+		}, pubsub.NewBroker[*booking.Occupancy](16), pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
+
+		strangerID := uuid.New().String()
+		resp, err := accessBetaRepo.CheckUserExit(context.Background(), &booking.AccessBetaPersonalRequest{
+			UserId:      strangerID,
+			SportHallId: gymID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "denied", resp.Message)
+		assert.Equal(t, "no entry recorded", resp.Reason)
+
+		history, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{
+			UserId: strangerID,
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, history.Events, 1) {
+			assert.Equal(t, "exit", history.Events[0].Direction)
+			assert.Equal(t, "denied", history.Events[0].Result)
+		}
+	})
+
+	t.Run("StreamAccessEventsResume", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+		}, pubsub.NewBroker[*booking.Occupancy](16), events, slog.Default())
+
+		filter := &booking.StreamAccessEventsRequest{GymId: gymID, UserId: userID}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := accessRepo.WatchAccessEvents(ctx, filter)
+
+		_, err := accessBetaRepo.CheckUserExit(context.Background(), req)
+		assert.NoError(t, err)
+		exit := <-updates
+		assert.Equal(t, "exit", exit.Direction)
+
+		_, err = accessBetaRepo.CheckUserAccess(context.Background(), req)
+		assert.NoError(t, err)
+		entry := <-updates
+		assert.Equal(t, "entry", entry.Direction)
+		assert.Equal(t, createdBooking.Id, entry.BookingId)
+		assert.Greater(t, entry.Id, exit.Id)
+
+		// A client that saw the exit resumes with the entry
+		replayed, err := accessRepo.ReplayAccessEvents(context.Background(), filter, exit.Id, 100)
+		assert.NoError(t, err)
+		if assert.Len(t, replayed, 1) {
+			assert.Equal(t, entry.Id, replayed[0].Id)
+		}
+
+		replayed, err = accessRepo.ReplayAccessEvents(context.Background(), &booking.StreamAccessEventsRequest{
+			GymId:       gymID,
+			BookingType: "group",
+		}, exit.Id, 100)
+		assert.NoError(t, err)
+		assert.Len(t, replayed, 0)
+	})
+
+	t.Run("CheckInWithToken", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+			CheckInTokenSecret:   "secret",
+			CheckInTokenPeriod:   30 * time.Second,
+		}, pubsub.NewBroker[*booking.Occupancy](16), events, slog.Default())
+
+		_, err := accessBetaRepo.CheckUserExit(context.Background(), req)
+		assert.NoError(t, err)
+
+		// Tokens are only issued to the calling member
+		_, err = accessBetaRepo.IssueCheckInToken(context.Background(), &booking.IssueCheckInTokenRequest{SportHallId: gymID})
+		assert.ErrorIs(t, err, storage.ErrUnauthenticated)
+
+		member := audit.NewContext(context.Background(), audit.Metadata{Actor: userID})
+		token, err := accessBetaRepo.IssueCheckInToken(member, &booking.IssueCheckInTokenRequest{SportHallId: gymID})
+		assert.NoError(t, err)
+
+		// Asking again within the same step gives a fresh token
+		retried, err := accessBetaRepo.IssueCheckInToken(member, &booking.IssueCheckInTokenRequest{SportHallId: gymID})
+		assert.NoError(t, err)
+		assert.NotEqual(t, token.Token, retried.Token)
+
+		// A token issued for this hall does not open another one
+		resp, err := accessBetaRepo.CheckInWithToken(context.Background(), &booking.CheckInWithTokenRequest{
+			Token:       token.Token,
+			SportHallId: uuid.New().String(),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "invalid or expired check-in token", resp.Reason)
+
+		scan := &booking.CheckInWithTokenRequest{
+			Token:       token.Token,
+			SportHallId: gymID,
+		}
+		resp, err = accessBetaRepo.CheckInWithToken(context.Background(), scan)
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+
+		resp, err = accessBetaRepo.CheckInWithToken(context.Background(), scan)
+		assert.NoError(t, err)
+		assert.Equal(t, "denied", resp.Message)
+		assert.Equal(t, "check-in token already used", resp.Reason)
+	})
+
+	t.Run("CheckInWithFace", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+			FaceMinConfidence:    0.9,
+		}, pubsub.NewBroker[*booking.Occupancy](16), events, slog.Default())
+		accessBetaRepo.SetFaceResolver(faceResolver{"face-1": userID})
+
+		_, err := accessBetaRepo.CheckUserExit(context.Background(), req)
+		assert.NoError(t, err)
+
+		deviceID := uuid.New().String()
+		scan := &booking.FaceCheckInRequest{
+			FaceId:      "face-1",
+			Confidence:  0.5,
+			DeviceId:    deviceID,
+			SportHallId: gymID,
+		}
+
+		resp, err := accessBetaRepo.CheckInWithFace(context.Background(), scan)
+		assert.NoError(t, err)
+		assert.Equal(t, "face match confidence too low", resp.Reason)
+
+		scan.FaceId = "face-2"
+		scan.Confidence = 0.95
+		resp, err = accessBetaRepo.CheckInWithFace(context.Background(), scan)
+		assert.NoError(t, err)
+		assert.Equal(t, "face not recognized", resp.Reason)
+
+		scan.FaceId = "face-1"
+		resp, err = accessBetaRepo.CheckInWithFace(context.Background(), scan)
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+
+		var attempts int
+		err = db.QueryRow(context.Background(), `SELECT COUNT(*) FROM face_checkin_attempts WHERE device_id = $1`, deviceID).Scan(&attempts)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("ListSuspiciousAccess", func(t *testing.T) {
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+			AccessAuditRetention: 24 * time.Hour,
+		}, pubsub.NewBroker[*booking.Occupancy](16), events, slog.Default())
+
+		// Someone without a booking tries the same ID three times
+		strangerID := uuid.New().String()
+		deviceID := uuid.New().String()
+		for i := 0; i < 3; i++ {
+			resp, err := accessBetaRepo.CheckUserAccess(context.Background(), &booking.AccessBetaPersonalRequest{
+				UserId:      strangerID,
+				SportHallId: gymID,
+				DeviceId:    deviceID,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "denied", resp.Message)
+		}
+
+		history, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{UserId: strangerID})
+		assert.NoError(t, err)
+		if assert.Len(t, history.Events, 3) {
+			assert.Equal(t, deviceID, history.Events[0].DeviceId)
+			assert.Equal(t, "user_id", history.Events[0].CredentialType)
+			assert.Equal(t, "no active booking", history.Events[0].Reason)
+		}
+
+		suspicious, err := accessRepo.ListSuspiciousAccess(context.Background(), &booking.ListSuspiciousAccessRequest{
+			GymId:      gymID,
+			MinDenials: 3,
+		})
+		assert.NoError(t, err)
+
+		var byUser, byDevice *booking.SuspiciousAccess
+		for _, pattern := range suspicious.Patterns {
+			if pattern.Kind == "repeated_denials" && pattern.UserId == strangerID {
+				byUser = pattern
+			}
+			if pattern.Kind == "device_denials" && pattern.DeviceId == deviceID {
+				byDevice = pattern
+			}
+		}
+		if assert.NotNil(t, byUser) {
+			assert.Equal(t, int64(3), byUser.Denials)
+			assert.Equal(t, []string{"no active booking"}, byUser.Reasons)
+		}
+		assert.NotNil(t, byDevice)
+	})
+
+	t.Run("PruneKeepsGrantedVisits", func(t *testing.T) {
+		memberID := uuid.New().String()
+		_, err := db.Exec(context.Background(), `
+			INSERT INTO access_events (gym_id, user_id, direction, counted, result, reason, created_at)
+			VALUES
+				($1, $2, 'entry', TRUE, 'granted', NULL, NOW() - INTERVAL '2 days'),
+				($1, $2, 'entry', FALSE, 'denied', 'no active booking', NOW() - INTERVAL '2 days')
+		`, gymID, memberID)
+		assert.NoError(t, err)
+
+		// Any attempt on a new repo prunes straight away
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{
+			AccessReentryTimeout: time.Hour,
+			AccessAuditRetention: 24 * time.Hour,
+		}, pubsub.NewBroker[*booking.Occupancy](16), events, slog.Default())
+		_, err = accessBetaRepo.CheckUserAccess(context.Background(), &booking.AccessBetaPersonalRequest{
+			UserId:      uuid.New().String(),
+			SportHallId: gymID,
+		})
+		assert.NoError(t, err)
+
+		history, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{UserId: memberID})
+		assert.NoError(t, err)
+		if assert.Len(t, history.Events, 1) {
+			assert.Equal(t, "granted", history.Events[0].Result)
+		}
+	})
+}
+
+// faceResolver resolves faces from a fixed map.
+type faceResolver map[string]string
+
+func (r faceResolver) ResolveFace(ctx context.Context, faceID string) (string, error) {
+	return r[faceID], nil
+}
@@ -2,21 +2,23 @@ package test
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 	"time"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestAccessRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	accessRepo := postgres.NewAccessRepo(db)
+	accessRepo := postgres.NewAccessRepo(db, pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -28,11 +30,61 @@ func TestAccessRepo(t *testing.T) {
 	testAccessPersonal(t, db, accessRepo, gymID, userID)
 	testAccessGroup(t, db, accessRepo, gymID, coachID, userID)
 	testAccessCoach(t, db, accessRepo, gymID, coachID, userID)
+
+	t.Run("ListAccessHistory", func(t *testing.T) {
+		history, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{
+			UserId: userID,
+			GymId:  gymID,
+			From:   time.Now().Add(-time.Hour).Format(time.RFC3339),
+			Limit:  2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), history.Total) // one visit per booking type
+		assert.Equal(t, int64(3), history.Granted)
+		assert.Equal(t, int64(0), history.Denied)
+		assert.Equal(t, int64(3), history.Visits)
+		assert.Len(t, history.Events, 2)
+
+		nextPage, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{
+			UserId: userID,
+			GymId:  gymID,
+			Limit:  2,
+			Page:   2,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, nextPage.Events, 1)
+
+		coachVisits, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{
+			UserId:      userID,
+			BookingType: "coach",
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, coachVisits.Events, 1) {
+			assert.Equal(t, "granted", coachVisits.Events[0].Result)
+		}
+	})
+
+	t.Run("ListAccessHistoryCountsVisits", func(t *testing.T) {
+		// An exit and a repeated scan are granted but are not visits
+		_, err := db.Exec(context.Background(), `
+			INSERT INTO access_events (gym_id, user_id, direction, counted, result)
+			VALUES ($1, $2, 'exit', FALSE, 'granted'), ($1, $2, 'entry', FALSE, 'granted')
+		`, gymID, userID)
+		assert.NoError(t, err)
+
+		history, err := accessRepo.ListAccessHistory(context.Background(), &booking.ListAccessHistoryRequest{
+			UserId: userID,
+			GymId:  gymID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), history.Granted)
+		assert.Equal(t, int64(3), history.Visits)
+	})
 }
 
-func testAccessPersonal(t *testing.T, db *pgx.Conn, accessRepo *postgres.AccessRepo, gymID, userID string) {
-	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db)
-	bookingRepo := postgres.NewBookingPersonalRepo(db)
+func testAccessPersonal(t *testing.T, db *pgxpool.Pool, accessRepo *postgres.AccessRepo, gymID, userID string) {
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
 
 	// 1. Create a Subscription Personal
 	createSubscriptionReq := &booking.CreateSubscriptionPersonalRequest{
@@ -90,11 +142,48 @@ func testAccessPersonal(t *testing.T, db *pgx.Conn, accessRepo *postgres.AccessR
 		assert.NotNil(t, listResponse)
 		assert.GreaterOrEqual(t, len(listResponse.AccessPersonal), 1) // At least 1 access record
 	})
+
+	t.Run("CreateAccessPersonalOutsideAllowedHours", func(t *testing.T) {
+		// Allow only a day other than today (the test gym runs on UTC)
+		createdSubscription.TimeWindows = []*booking.TimeWindow{
+			{Weekday: int32(time.Now().UTC().Weekday()+3) % 7, StartTime: "00:00", EndTime: "23:59"},
+		}
+		updatedSubscription, err := subscriptionRepo.UpdateSubscriptionPersonal(context.Background(), &booking.UpdateSubscriptionPersonalRequest{
+			SubscriptionPersonal: createdSubscription,
+			SetTimeWindows:       true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, updatedSubscription.TimeWindows, 1)
+		assert.Equal(t, createdBooking.SubscriptionVersion+1, updatedSubscription.Version)
+
+		// Bookings sold under the new version only get in inside its windows;
+		// the booking above keeps the hours it was sold with
+		restrictedBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         userID,
+				SubscriptionId: createdSubscription.Id,
+				Payment:        100,
+				AccessStatus:   "granted",
+				StartDate:      time.Now().Format(time.RFC3339),
+				Count:          10,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteBookingPersonal(t, db, restrictedBooking.Id)
+
+		_, err = accessRepo.CreateAccessPersonal(context.Background(), &booking.CreateAccessPersonalRequest{
+			AccessPersonal: &booking.AccessPersonal{
+				BookingPersonalId: restrictedBooking.Id,
+				Date:              time.Now().Format(time.RFC3339),
+			},
+		})
+		assert.ErrorContains(t, err, "outside allowed hours")
+	})
 }
 
-func testAccessGroup(t *testing.T, db *pgx.Conn, accessRepo *postgres.AccessRepo, gymID, coachID, userID string) {
-	subscriptionRepo := postgres.NewSubscriptionGroupRepo(db)
-	bookingRepo := postgres.NewBookingGroupRepo(db)
+func testAccessGroup(t *testing.T, db *pgxpool.Pool, accessRepo *postgres.AccessRepo, gymID, coachID, userID string) {
+	subscriptionRepo := postgres.NewSubscriptionGroupRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingGroupRepo(db, slog.Default())
 
 	// 1. Create a Subscription Group
 	createSubscriptionReq := &booking.CreateSubscriptionGroupRequest{
@@ -156,9 +245,9 @@ func testAccessGroup(t *testing.T, db *pgx.Conn, accessRepo *postgres.AccessRepo
 	})
 }
 
-func testAccessCoach(t *testing.T, db *pgx.Conn, accessRepo *postgres.AccessRepo, gymID, coachID, userID string) {
-	subscriptionRepo := postgres.NewSubscriptionCoachRepo(db)
-	bookingRepo := postgres.NewBookingCoachRepo(db)
+func testAccessCoach(t *testing.T, db *pgxpool.Pool, accessRepo *postgres.AccessRepo, gymID, coachID, userID string) {
+	subscriptionRepo := postgres.NewSubscriptionCoachRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingCoachRepo(db, slog.Default())
 
 	// 1. Create a Subscription Coach
 	createSubscriptionReq := &booking.CreateSubscriptionCoachRequest{
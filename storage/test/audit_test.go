@@ -0,0 +1,107 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	auditRepo := postgres.NewAuditRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	staffID := uuid.New().String()
+	ctx := audit.NewContext(context.Background(), audit.Metadata{
+		Actor:     staffID,
+		RPC:       "/gym.BookingPersonalService/UpdateBookingPersonal",
+		RequestID: uuid.New().String(),
+	})
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "Monthly",
+			Price:    100,
+			Duration: 30,
+			Count:    12,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createdBooking, err := bookingRepo.CreateBookingPersonal(ctx, &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         uuid.New().String(),
+			SubscriptionId: createdSubscription.Id,
+			Payment:        100,
+			AccessStatus:   "granted",
+			StartDate:      time.Now().Format(time.RFC3339),
+			Count:          1,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+	createdBooking.Payment = 80
+	_, err = bookingRepo.UpdateBookingPersonal(ctx, &booking.UpdateBookingPersonalRequest{BookingPersonal: createdBooking})
+	assert.NoError(t, err)
+
+	t.Run("GetEntityHistory", func(t *testing.T) {
+		history, err := auditRepo.GetEntityHistory(context.Background(), &booking.GetEntityHistoryRequest{
+			EntityType: "booking_personal",
+			EntityId:   createdBooking.Id,
+		})
+		assert.NoError(t, err)
+		if !assert.Len(t, history.Entries, 2) {
+			return
+		}
+
+		created, updated := history.Entries[0], history.Entries[1]
+		assert.Equal(t, "create", created.Action)
+		assert.Empty(t, created.Before)
+		assert.Equal(t, staffID, created.Actor)
+
+		assert.Equal(t, "update", updated.Action)
+		assert.Equal(t, staffID, updated.Actor)
+		assert.Equal(t, "/gym.BookingPersonalService/UpdateBookingPersonal", updated.Rpc)
+		assert.NotEmpty(t, updated.RequestId)
+
+		var before, after map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(updated.Before), &before))
+		assert.NoError(t, json.Unmarshal([]byte(updated.After), &after))
+		assert.EqualValues(t, 100, before["payment"])
+		assert.EqualValues(t, 80, after["payment"])
+	})
+
+	t.Run("ChangesOutsideRequestHaveNoActor", func(t *testing.T) {
+		history, err := auditRepo.GetEntityHistory(context.Background(), &booking.GetEntityHistoryRequest{
+			EntityType: "subscription_personal",
+			EntityId:   createdSubscription.Id,
+		})
+		assert.NoError(t, err)
+		if assert.NotEmpty(t, history.Entries) {
+			assert.Equal(t, "create", history.Entries[0].Action)
+			assert.Empty(t, history.Entries[0].Actor)
+		}
+	})
+
+	t.Run("AuditLogIsAppendOnly", func(t *testing.T) {
+		_, err := db.Exec(context.Background(), `DELETE FROM audit_log WHERE entity_id = $1`, createdBooking.Id)
+		assert.ErrorContains(t, err, "append-only")
+	})
+}
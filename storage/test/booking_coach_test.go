@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -9,15 +10,16 @@ import (
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBookingCoachRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	bookingRepo := postgres.NewBookingCoachRepo(db)
-	subscriptionRepo := postgres.NewSubscriptionCoachRepo(db) // For creating subscriptions
+	bookingRepo := postgres.NewBookingCoachRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionCoachRepo(db, slog.Default()) // For creating subscriptions
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -296,7 +298,7 @@ func TestBookingCoachRepo(t *testing.T) {
 	})
 }
 
-func deleteBookingCoach(t *testing.T, db *pgx.Conn, id string) {
+func deleteBookingCoach(t *testing.T, db *pgxpool.Pool, id string) {
 	// _, err := db.Exec(context.Background(), "DELETE FROM booking_coach WHERE id = $1", id)
 	// assert.NoError(t, err)
 }
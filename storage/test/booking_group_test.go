@@ -2,22 +2,25 @@ package test
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 	"time"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBookingGroupRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	bookingRepo := postgres.NewBookingGroupRepo(db)
-	subscriptionRepo := postgres.NewSubscriptionGroupRepo(db) // For creating subscriptions
+	bookingRepo := postgres.NewBookingGroupRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionGroupRepo(db, slog.Default()) // For creating subscriptions
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -68,6 +71,48 @@ func TestBookingGroupRepo(t *testing.T) {
 		defer deleteSubscriptionGroup(t, db, createdSubscription.Id)
 	})
 
+	t.Run("CreateBookingGroupFull", func(t *testing.T) {
+		createdSubscription, err := subscriptionRepo.CreateSubscriptionGroup(context.Background(), &booking.CreateSubscriptionGroupRequest{
+			SubscriptionGroup: &booking.SubscriptionGroup{
+				GymId:    gymID,
+				CoachId:  coachID,
+				Type:     "Small Group",
+				Price:    50,
+				Capacity: 1,
+				Time:     time.Now().Format(time.RFC3339),
+				Duration: 2,
+				Count:    10,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteSubscriptionGroup(t, db, createdSubscription.Id)
+
+		// The booking still holds its place hours after it started, since
+		// the duration is in days
+		createdBooking, err := bookingRepo.CreateBookingGroup(context.Background(), &booking.CreateBookingGroupRequest{
+			BookingGroup: &booking.BookingGroup{
+				UserId:         userID,
+				SubscriptionId: createdSubscription.Id,
+				Payment:        50,
+				StartDate:      time.Now().Add(-3 * time.Hour).Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteBookingGroup(t, db, createdBooking.Id)
+
+		_, err = bookingRepo.CreateBookingGroup(context.Background(), &booking.CreateBookingGroupRequest{
+			BookingGroup: &booking.BookingGroup{
+				UserId:         uuid.New().String(),
+				SubscriptionId: createdSubscription.Id,
+				Payment:        50,
+				StartDate:      time.Now().Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.ErrorIs(t, err, storage.ErrFailedPrecondition)
+	})
+
 	t.Run("GetBookingGroup", func(t *testing.T) {
 		// Create a subscription first
 		createSubscriptionReq := &booking.CreateSubscriptionGroupRequest{
@@ -302,7 +347,7 @@ func TestBookingGroupRepo(t *testing.T) {
 	})
 }
 
-func deleteBookingGroup(t *testing.T, db *pgx.Conn, id string) {
+func deleteBookingGroup(t *testing.T, db *pgxpool.Pool, id string) {
 	// _, err := db.Exec(context.Background(), "DELETE FROM booking_group WHERE id = $1", id)
 	// assert.NoError(t, err)
 }
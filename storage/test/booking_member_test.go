@@ -0,0 +1,117 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingMemberRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	memberRepo := postgres.NewBookingMemberRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	holderID := uuid.New().String()
+	memberID := uuid.New().String()
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:       gymID,
+			Type:        "Family",
+			Description: "Family plan",
+			Price:       300,
+			Duration:    30,
+			Count:       20,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         holderID,
+			SubscriptionId: createdSubscription.Id,
+			Payment:        300,
+			StartDate:      time.Now().Add(time.Hour).Format(time.RFC3339),
+			Count:          1,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+	// Members are managed by the account holder calling for themselves
+	asHolder := audit.NewContext(context.Background(), audit.Metadata{Actor: holderID})
+
+	t.Run("AddBookingMember", func(t *testing.T) {
+		req := &booking.AddBookingMemberRequest{
+			BookingMember: &booking.BookingMember{
+				BookingId:   createdBooking.Id,
+				BookingType: "personal",
+				UserId:      memberID,
+				VisitLimit:  5,
+			},
+		}
+
+		member, err := memberRepo.AddBookingMember(asHolder, req)
+		assert.NoError(t, err)
+		assert.NotNil(t, member)
+		assert.Equal(t, memberID, member.UserId)
+		assert.Equal(t, int32(5), member.VisitLimit)
+	})
+
+	t.Run("AddBookingMemberRequiresHolder", func(t *testing.T) {
+		req := &booking.AddBookingMemberRequest{
+			BookingMember: &booking.BookingMember{
+				BookingId:   createdBooking.Id,
+				BookingType: "personal",
+				UserId:      uuid.New().String(),
+			},
+		}
+
+		asMember := audit.NewContext(context.Background(), audit.Metadata{Actor: memberID})
+		_, err := memberRepo.AddBookingMember(asMember, req)
+		assert.ErrorIs(t, err, storage.ErrPermissionDenied)
+
+		_, err = memberRepo.AddBookingMember(context.Background(), req)
+		assert.ErrorIs(t, err, storage.ErrUnauthenticated)
+	})
+
+	t.Run("ListBookingMembers", func(t *testing.T) {
+		listResponse, err := memberRepo.ListBookingMembers(context.Background(), &booking.ListBookingMembersRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, listResponse.BookingMembers, 1)
+		assert.Equal(t, int32(0), listResponse.BookingMembers[0].VisitsUsed)
+	})
+
+	t.Run("RemoveBookingMember", func(t *testing.T) {
+		req := &booking.RemoveBookingMemberRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+			UserId:      memberID,
+		}
+
+		err := memberRepo.RemoveBookingMember(asHolder, req)
+		assert.NoError(t, err)
+
+		err = memberRepo.RemoveBookingMember(asHolder, req)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
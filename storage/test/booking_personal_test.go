@@ -2,22 +2,26 @@ package test
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 	"time"
 
+	"github.com/Athlevo/Booking-Athlevo/audit"
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBookingPersonalRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	bookingRepo := postgres.NewBookingPersonalRepo(db)
-	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db) // For creating subscriptions
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default()) // For creating subscriptions
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -284,9 +288,90 @@ func TestBookingPersonalRepo(t *testing.T) {
 		defer deleteBookingPersonal(t, db, createdBooking2.Id)
 		defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
 	})
+
+	t.Run("ChangePlan", func(t *testing.T) {
+		basic, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+			SubscriptionPersonal: &booking.SubscriptionPersonal{
+				GymId:    gymID,
+				Type:     "Basic",
+				Price:    100,
+				Duration: 30,
+				Count:    10,
+			},
+		})
+		assert.NoError(t, err)
+
+		premium, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+			SubscriptionPersonal: &booking.SubscriptionPersonal{
+				GymId:    gymID,
+				Type:     "Premium",
+				Price:    200,
+				Duration: 30,
+				Count:    20,
+			},
+		})
+		assert.NoError(t, err)
+
+		// Not started yet, so the whole payment is credited
+		createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         userID,
+				SubscriptionId: basic.Id,
+				Payment:        100,
+				StartDate:      time.Now().Add(time.Hour).Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.NoError(t, err)
+
+		// Only the account holder can change the plan
+		asHolder := audit.NewContext(context.Background(), audit.Metadata{Actor: userID})
+		asStranger := audit.NewContext(context.Background(), audit.Metadata{Actor: uuid.New().String()})
+		_, err = bookingRepo.ChangePlan(asStranger, &booking.ChangePlanRequest{
+			BookingId:         createdBooking.Id,
+			NewSubscriptionId: premium.Id,
+			ExtraPayment:      100,
+		})
+		assert.ErrorIs(t, err, storage.ErrPermissionDenied)
+
+		// Credit alone does not cover the upgrade
+		_, err = bookingRepo.ChangePlan(asHolder, &booking.ChangePlanRequest{
+			BookingId:         createdBooking.Id,
+			NewSubscriptionId: premium.Id,
+		})
+		assert.Error(t, err)
+
+		resp, err := bookingRepo.ChangePlan(asHolder, &booking.ChangePlanRequest{
+			BookingId:         createdBooking.Id,
+			NewSubscriptionId: premium.Id,
+			ExtraPayment:      100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(100), resp.Credit)
+		assert.Equal(t, "closed", resp.OldBooking.AccessStatus)
+		assert.NotEmpty(t, resp.OldBooking.ClosedAt)
+		assert.Equal(t, premium.Id, resp.NewBooking.SubscriptionId)
+		assert.Equal(t, int32(200), resp.NewBooking.Payment)
+		// The new booking takes its visit limit from the new plan
+		assert.Equal(t, int32(20), resp.NewBooking.Count)
+		assert.Equal(t, userID, resp.NewBooking.UserId)
+
+		// A closed booking cannot be changed again
+		_, err = bookingRepo.ChangePlan(asHolder, &booking.ChangePlanRequest{
+			BookingId:         createdBooking.Id,
+			NewSubscriptionId: premium.Id,
+		})
+		assert.Error(t, err)
+
+		// Cleanup
+		defer deleteBookingPersonal(t, db, createdBooking.Id)
+		defer deleteBookingPersonal(t, db, resp.NewBooking.Id)
+		defer deleteSubscriptionPersonal(t, db, basic.Id)
+		defer deleteSubscriptionPersonal(t, db, premium.Id)
+	})
 }
 
-func deleteBookingPersonal(t *testing.T, db *pgx.Conn, id string) {
+func deleteBookingPersonal(t *testing.T, db *pgxpool.Pool, id string) {
 	// _, err := db.Exec(context.Background(), "DELETE FROM booking_personal WHERE id = $1", id)
 	// assert.NoError(t, err)
 }
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/audit"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingTransferRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	transferRepo := postgres.NewBookingTransferRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	fromUserID := uuid.New().String()
+	toUserID := uuid.New().String()
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:       gymID,
+			Type:        "Monthly",
+			Description: "Monthly gym access",
+			Price:       100,
+			Duration:    30,
+			Count:       12,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         fromUserID,
+			SubscriptionId: createdSubscription.Id,
+			Payment:        100,
+			StartDate:      time.Now().Format(time.RFC3339),
+			Count:          1,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+	fromCtx := audit.NewContext(context.Background(), audit.Metadata{Actor: fromUserID})
+	toCtx := audit.NewContext(context.Background(), audit.Metadata{Actor: toUserID})
+
+	t.Run("TransferBookingNotTransferable", func(t *testing.T) {
+		_, err := transferRepo.TransferBooking(fromCtx, &booking.TransferBookingRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+			ToUserId:    toUserID,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("TransferBooking", func(t *testing.T) {
+		rule, err := transferRepo.SetTransferRule(context.Background(), &booking.SetTransferRuleRequest{
+			TransferRule: &booking.TransferRule{
+				SubscriptionId:   createdSubscription.Id,
+				SubscriptionType: "personal",
+				Transferable:     true,
+				Fee:              10,
+				MaxTransfers:     1,
+			},
+		})
+		assert.NoError(t, err)
+		assert.True(t, rule.Transferable)
+
+		// Only the calling account holder can transfer the booking
+		_, err = transferRepo.TransferBooking(toCtx, &booking.TransferBookingRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+			ToUserId:    uuid.New().String(),
+			FeePayment:  10,
+		})
+		assert.ErrorIs(t, err, storage.ErrPermissionDenied)
+
+		// The fee must be paid
+		_, err = transferRepo.TransferBooking(fromCtx, &booking.TransferBookingRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+			ToUserId:    toUserID,
+			FeePayment:  5,
+		})
+		assert.Error(t, err)
+
+		transfer, err := transferRepo.TransferBooking(fromCtx, &booking.TransferBookingRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+			ToUserId:    toUserID,
+			FeePayment:  10,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, transfer)
+		assert.Equal(t, int32(12), transfer.RemainingVisits)
+
+		transferredBooking, err := bookingRepo.GetBookingPersonal(context.Background(), &booking.GetBookingPersonalRequest{Id: createdBooking.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, toUserID, transferredBooking.UserId)
+		assert.Equal(t, createdBooking.AccessStatus, transferredBooking.AccessStatus)
+
+		assert.Equal(t, fromUserID, transfer.FromUserId)
+
+		// The plan allows one transfer only
+		_, err = transferRepo.TransferBooking(toCtx, &booking.TransferBookingRequest{
+			BookingId:   createdBooking.Id,
+			BookingType: "personal",
+			ToUserId:    fromUserID,
+			FeePayment:  10,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("ListBookingTransfers", func(t *testing.T) {
+		for _, userID := range []string{fromUserID, toUserID} {
+			listResponse, err := transferRepo.ListBookingTransfers(context.Background(), &booking.ListBookingTransfersRequest{
+				UserId: userID,
+			})
+			assert.NoError(t, err)
+			assert.Len(t, listResponse.BookingTransfers, 1)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundleRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	bundleRepo := postgres.NewBundleRepo(db, slog.Default())
+	personalRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+	groupRepo := postgres.NewSubscriptionGroupRepo(db, slog.Default())
+	coachRepo := postgres.NewSubscriptionCoachRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	userID := uuid.New().String()
+	coachID := uuid.New().String()
+
+	personal, err := personalRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "Gym access",
+			Price:    100,
+			Duration: 30,
+			Count:    30,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, personal.Id)
+
+	group, err := groupRepo.CreateSubscriptionGroup(context.Background(), &booking.CreateSubscriptionGroupRequest{
+		SubscriptionGroup: &booking.SubscriptionGroup{
+			GymId:    gymID,
+			CoachId:  coachID,
+			Type:     "Yoga",
+			Price:    80,
+			Capacity: 20,
+			Time:     time.Now().Format(time.RFC3339),
+			Duration: 30,
+			Count:    8,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionGroup(t, db, group.Id)
+
+	coach, err := coachRepo.CreateSubscriptionCoach(context.Background(), &booking.CreateSubscriptionCoachRequest{
+		SubscriptionCoach: &booking.SubscriptionCoach{
+			GymId:    gymID,
+			CoachId:  coachID,
+			Type:     "PT sessions",
+			Price:    120,
+			Duration: 720,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionCoach(t, db, coach.Id)
+
+	var createdBundle *booking.Bundle
+
+	t.Run("CreateBundle", func(t *testing.T) {
+		createdBundle, err = bundleRepo.CreateBundle(context.Background(), &booking.CreateBundleRequest{
+			Bundle: &booking.Bundle{
+				GymId: gymID,
+				Name:  "Gym + yoga + PT",
+				Price: 250,
+				Items: []*booking.BundleItem{
+					{SubscriptionType: "personal", SubscriptionId: personal.Id},
+					{SubscriptionType: "group", SubscriptionId: group.Id},
+					{SubscriptionType: "coach", SubscriptionId: coach.Id},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, createdBundle.Id)
+
+		fetched, err := bundleRepo.GetBundle(context.Background(), &booking.GetBundleRequest{Id: createdBundle.Id})
+		assert.NoError(t, err)
+		assert.Len(t, fetched.Items, 3)
+	})
+
+	t.Run("PurchaseBundle", func(t *testing.T) {
+		// The payment must cover the bundle price
+		_, err := bundleRepo.PurchaseBundle(context.Background(), &booking.PurchaseBundleRequest{
+			BundleId: createdBundle.Id,
+			UserId:   userID,
+			Payment:  200,
+		})
+		assert.Error(t, err)
+
+		purchase, err := bundleRepo.PurchaseBundle(context.Background(), &booking.PurchaseBundleRequest{
+			BundleId: createdBundle.Id,
+			UserId:   userID,
+			Payment:  250,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, purchase.Bookings, 3)
+
+		var total int32
+		for _, b := range purchase.Bookings {
+			total += b.Payment
+			assert.Equal(t, "granted", b.AccessStatus)
+		}
+		assert.Equal(t, int32(250), total)
+
+		personalBooking, err := bookingRepo.GetBookingPersonal(context.Background(), &booking.GetBookingPersonalRequest{Id: purchase.Bookings[0].BookingId})
+		assert.NoError(t, err)
+		assert.Equal(t, purchase.Id, personalBooking.BundlePurchaseId)
+
+		listResponse, err := bundleRepo.ListBundlePurchases(context.Background(), &booking.ListBundlePurchasesRequest{UserId: userID})
+		assert.NoError(t, err)
+		assert.Len(t, listResponse.BundlePurchases, 1)
+		assert.Len(t, listResponse.BundlePurchases[0].Bookings, 3)
+	})
+
+	t.Run("DeleteBundle", func(t *testing.T) {
+		err := bundleRepo.DeleteBundle(context.Background(), &booking.DeleteBundleRequest{Id: createdBundle.Id})
+		assert.NoError(t, err)
+
+		_, err = bundleRepo.GetBundle(context.Background(), &booking.GetBundleRequest{Id: createdBundle.Id})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		// A bundle off sale cannot be bought
+		_, err = bundleRepo.PurchaseBundle(context.Background(), &booking.PurchaseBundleRequest{
+			BundleId: createdBundle.Id,
+			UserId:   userID,
+			Payment:  250,
+		})
+		assert.Error(t, err)
+	})
+}
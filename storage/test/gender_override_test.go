@@ -0,0 +1,168 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenderOverrideRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	overrideRepo := postgres.NewGenderOverrideRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	// The test gym is a male hall
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	userID := createUserWithGender(t, db, "female")
+	staffID := uuid.New().String()
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "Monthly",
+			Price:    100,
+			Duration: 30,
+			Count:    12,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	bookingReq := &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         userID,
+			SubscriptionId: createdSubscription.Id,
+			Payment:        100,
+			StartDate:      time.Now().Format(time.RFC3339),
+			Count:          1,
+		},
+	}
+
+	t.Run("CreateBookingDeniedByGender", func(t *testing.T) {
+		_, err := bookingRepo.CreateBookingPersonal(context.Background(), bookingReq)
+		assert.ErrorContains(t, err, "restricted to another gender")
+	})
+
+	t.Run("GrantGenderOverride", func(t *testing.T) {
+		// Staff and a reason are required
+		_, err := overrideRepo.GrantGenderOverride(context.Background(), &booking.GrantGenderOverrideRequest{
+			GenderOverride: &booking.GenderOverride{GymId: gymID, UserId: userID},
+		})
+		assert.Error(t, err)
+
+		override, err := overrideRepo.GrantGenderOverride(context.Background(), &booking.GrantGenderOverrideRequest{
+			GenderOverride: &booking.GenderOverride{
+				GymId:     gymID,
+				UserId:    userID,
+				GrantedBy: staffID,
+				Reason:    "Coach accompanying a class",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, staffID, override.GrantedBy)
+
+		createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), bookingReq)
+		assert.NoError(t, err)
+
+		// Cleanup
+		defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+		err = overrideRepo.RevokeGenderOverride(context.Background(), &booking.RevokeGenderOverrideRequest{
+			Id:        override.Id,
+			RevokedBy: staffID,
+		})
+		assert.NoError(t, err)
+
+		err = overrideRepo.RevokeGenderOverride(context.Background(), &booking.RevokeGenderOverrideRequest{
+			Id:        override.Id,
+			RevokedBy: staffID,
+		})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("MemberWithoutGender", func(t *testing.T) {
+		// Members whose gender staff have not recorded are let in on purpose
+		unknownID := uuid.New().String()
+		_, err := db.Exec(context.Background(), `
+			INSERT INTO users (id, username, email, password)
+			VALUES ($1, $2, $3, 'password')
+		`, unknownID, "user-"+unknownID, unknownID+"@example.com")
+		assert.NoError(t, err)
+		defer db.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, unknownID)
+
+		unknownReq := &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         unknownID,
+				SubscriptionId: createdSubscription.Id,
+				Payment:        100,
+				StartDate:      time.Now().Format(time.RFC3339),
+				Count:          1,
+			},
+		}
+		createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), unknownReq)
+		assert.NoError(t, err)
+		defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+		// Once recorded, the hall's rule applies
+		_, err = overrideRepo.SetMemberGender(context.Background(), &booking.SetMemberGenderRequest{
+			UserId: unknownID,
+			Gender: "female",
+		})
+		assert.Error(t, err)
+
+		gender, err := overrideRepo.SetMemberGender(context.Background(), &booking.SetMemberGenderRequest{
+			UserId: unknownID,
+			Gender: "female",
+			SetBy:  staffID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "female", gender.Gender)
+		assert.Equal(t, staffID, gender.SetBy)
+
+		unknownReq.BookingPersonal.Id = ""
+		_, err = bookingRepo.CreateBookingPersonal(context.Background(), unknownReq)
+		assert.ErrorContains(t, err, "restricted to another gender")
+	})
+
+	t.Run("ListGenderOverrides", func(t *testing.T) {
+		listResponse, err := overrideRepo.ListGenderOverrides(context.Background(), &booking.ListGenderOverridesRequest{
+			GymId: gymID,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, listResponse.GenderOverrides, 0)
+
+		listResponse, err = overrideRepo.ListGenderOverrides(context.Background(), &booking.ListGenderOverridesRequest{
+			GymId:          gymID,
+			IncludeRevoked: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, listResponse.GenderOverrides, 1)
+		assert.Equal(t, staffID, listResponse.GenderOverrides[0].RevokedBy)
+	})
+}
+
+func createUserWithGender(t *testing.T, db *pgxpool.Pool, gender string) string {
+	userID := uuid.New().String()
+	query := `
+		INSERT INTO users (id, username, email, password, gender)
+		VALUES ($1, $2, $3, 'password', $4)
+	`
+	_, err := db.Exec(context.Background(), query, userID, "user-"+userID, userID+"@example.com", gender)
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	return userID
+}
@@ -0,0 +1,155 @@
+package test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/notify"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	channel := notify.NewLogChannel(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scheduler := notify.NewScheduler(postgres.NewNotificationRepo(db, slog.Default()), channel, notify.Rules{
+		ExpiryNotice: 72 * time.Hour,
+		VisitsLeft:   2,
+		ClassNotice:  time.Hour,
+	}, 3, time.Minute, slog.Default())
+
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+	groupSubscriptionRepo := postgres.NewSubscriptionGroupRepo(db, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	groupBookingRepo := postgres.NewBookingGroupRepo(db, slog.Default())
+
+	// The test gym is a male hall
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	russianUserID := createUserWithSettings(t, db, "on", "ru")
+	mutedUserID := createUserWithSettings(t, db, "off", "en")
+
+	monthly, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{GymId: gymID, Type: "Monthly", Price: 100, Duration: 30, Count: 12},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, monthly.Id)
+
+	twoVisits, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{GymId: gymID, Type: "Two visits", Price: 20, Duration: 30, Count: 2},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, twoVisits.Id)
+
+	class, err := groupSubscriptionRepo.CreateSubscriptionGroup(context.Background(), &booking.CreateSubscriptionGroupRequest{
+		SubscriptionGroup: &booking.SubscriptionGroup{
+			GymId:    gymID,
+			CoachId:  uuid.New().String(),
+			Type:     "Yoga",
+			Price:    10,
+			Capacity: 10,
+			Time:     time.Now().Add(30 * time.Minute).Format(time.RFC3339),
+			Duration: 1,
+			Count:    1,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionGroup(t, db, class.Id)
+
+	// Each member has a plan ending in two days, one with two visits and a class in half an hour
+	for _, userID := range []string{russianUserID, mutedUserID} {
+		for _, plan := range []struct {
+			subscriptionID string
+			startDate      time.Time
+		}{
+			{monthly.Id, time.Now().AddDate(0, 0, -28)},
+			{twoVisits.Id, time.Now()},
+		} {
+			created, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+				BookingPersonal: &booking.BookingPersonal{
+					UserId:         userID,
+					SubscriptionId: plan.subscriptionID,
+					AccessStatus:   "granted",
+					StartDate:      plan.startDate.Format(time.RFC3339),
+					Count:          1,
+				},
+			})
+			assert.NoError(t, err)
+			defer deleteBookingPersonal(t, db, created.Id)
+		}
+
+		created, err := groupBookingRepo.CreateBookingGroup(context.Background(), &booking.CreateBookingGroupRequest{
+			BookingGroup: &booking.BookingGroup{
+				UserId:         userID,
+				SubscriptionId: class.Id,
+				AccessStatus:   "granted",
+				StartDate:      time.Now().Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteBookingGroup(t, db, created.Id)
+	}
+
+	// sentTo returns the messages sent to a member by kind
+	sentTo := func(userID string) map[string]notify.Message {
+		sent := make(map[string]notify.Message)
+		for _, msg := range channel.Sent() {
+			if msg.UserID == userID {
+				sent[msg.Kind] = msg
+			}
+		}
+		return sent
+	}
+
+	t.Run("SendRemindersInMemberLanguage", func(t *testing.T) {
+		_, err := scheduler.Tick(context.Background())
+		assert.NoError(t, err)
+
+		sent := sentTo(russianUserID)
+		assert.Len(t, sent, 3)
+		assert.Equal(t, "Ваш абонемент скоро закончится", sent[notify.KindPlanExpiring].Title)
+		assert.Contains(t, sent[notify.KindVisitsLeft].Body, "2")
+		assert.Contains(t, sent, notify.KindClassStarting)
+	})
+
+	t.Run("RespectNotificationSetting", func(t *testing.T) {
+		assert.Empty(t, sentTo(mutedUserID))
+	})
+
+	t.Run("RemindOnce", func(t *testing.T) {
+		before := len(channel.Sent())
+
+		_, err := scheduler.Tick(context.Background())
+		assert.NoError(t, err)
+
+		var repeated int
+		for _, msg := range channel.Sent()[before:] {
+			if msg.UserID == russianUserID {
+				repeated++
+			}
+		}
+		assert.Zero(t, repeated)
+	})
+}
+
+func createUserWithSettings(t *testing.T, db *pgxpool.Pool, notification, language string) string {
+	userID := createUserWithGender(t, db, "male")
+	_, err := db.Exec(context.Background(), `
+		INSERT INTO settings (user_id, notification, language)
+		VALUES ($1, $2, $3)
+	`, userID, notification, language)
+	if err != nil {
+		t.Fatalf("Failed to create settings: %v", err)
+	}
+	return userID
+}
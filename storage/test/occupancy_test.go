@@ -0,0 +1,117 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOccupancyRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	cfg := config.Config{AccessReentryTimeout: time.Hour}
+	broker := pubsub.NewBroker[*booking.Occupancy](16)
+
+	occupancyRepo := postgres.NewOccupancyRepo(db, cfg, broker, slog.Default())
+	accessBetaRepo := postgres.NewAccessBetaRepo(db, cfg, broker, pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "Monthly",
+			Price:    100,
+			Duration: 30,
+			Count:    30,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	var userIDs []string
+	for i := 0; i < 2; i++ {
+		userID := uuid.New().String()
+		createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         userID,
+				SubscriptionId: createdSubscription.Id,
+				Payment:        100,
+				StartDate:      time.Now().Add(-time.Minute).Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteBookingPersonal(t, db, createdBooking.Id)
+		userIDs = append(userIDs, userID)
+	}
+
+	t.Run("SetMaxOccupancy", func(t *testing.T) {
+		occupancy, err := occupancyRepo.SetMaxOccupancy(context.Background(), &booking.SetMaxOccupancyRequest{
+			GymId:        gymID,
+			MaxOccupancy: 1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), occupancy.MaxOccupancy)
+		assert.Equal(t, int32(0), occupancy.Current)
+	})
+
+	t.Run("FullHallDeniesEntry", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		updates := occupancyRepo.WatchOccupancy(ctx, gymID)
+
+		resp, err := accessBetaRepo.CheckUserAccess(context.Background(), &booking.AccessBetaPersonalRequest{
+			UserId:      userIDs[0],
+			SportHallId: gymID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+		assert.Equal(t, int32(1), (<-updates).Current)
+
+		resp, err = accessBetaRepo.CheckUserAccess(context.Background(), &booking.AccessBetaPersonalRequest{
+			UserId:      userIDs[1],
+			SportHallId: gymID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "denied", resp.Message)
+		assert.Equal(t, "hall is at maximum occupancy", resp.Reason)
+
+		_, err = accessBetaRepo.CheckUserExit(context.Background(), &booking.AccessBetaPersonalRequest{
+			UserId:      userIDs[0],
+			SportHallId: gymID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), (<-updates).Current)
+
+		occupancy, err := occupancyRepo.GetOccupancy(context.Background(), &booking.GetOccupancyRequest{GymId: gymID})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), occupancy.Current)
+	})
+
+	t.Run("BackdatedEntryAfterExit", func(t *testing.T) {
+		// A visit recorded later for a time before the member's exit does
+		// not put them back inside
+		_, err := db.Exec(context.Background(), `
+			INSERT INTO access_events (gym_id, user_id, direction, counted, created_at, result)
+			VALUES ($1, $2, 'entry', TRUE, NOW() - INTERVAL '10 minutes', 'granted')
+		`, gymID, userIDs[0])
+		assert.NoError(t, err)
+
+		occupancy, err := occupancyRepo.GetOccupancy(context.Background(), &booking.GetOccupancyRequest{GymId: gymID})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), occupancy.Current)
+	})
+}
@@ -0,0 +1,164 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/checkin"
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOfflineAccessRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	secret := "secret"
+	offlineRepo := postgres.NewOfflineAccessRepo(db, config.Config{OfflineExportSecret: secret}, pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	userID := uuid.New().String()
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "3 visits",
+			Price:    100,
+			Duration: 30,
+			Count:    3,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         userID,
+			SubscriptionId: createdSubscription.Id,
+			Payment:        100,
+			StartDate:      time.Now().Add(-time.Hour).Format(time.RFC3339),
+			Count:          1,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+	var version int64
+
+	t.Run("ExportFullSnapshot", func(t *testing.T) {
+		snapshot, err := offlineRepo.ExportOfflineAccess(context.Background(), &booking.ExportOfflineAccessRequest{GymId: gymID})
+		assert.NoError(t, err)
+		if assert.Len(t, snapshot.Credentials, 1) {
+			assert.Equal(t, userID, snapshot.Credentials[0].UserId)
+			assert.Equal(t, int32(3), snapshot.Credentials[0].VisitsLeft)
+		}
+
+		// Controllers verify the signature over the snapshot without it
+		unsigned := proto.Clone(snapshot).(*booking.OfflineAccessSnapshot)
+		unsigned.Signature = ""
+		payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(unsigned)
+		assert.NoError(t, err)
+		assert.True(t, checkin.VerifySignature([]byte(secret), payload, snapshot.Signature))
+
+		version = snapshot.Version
+
+		// Nothing changed, so the version stays the same
+		delta, err := offlineRepo.ExportOfflineAccess(context.Background(), &booking.ExportOfflineAccessRequest{
+			GymId:        gymID,
+			SinceVersion: version,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, version, delta.Version)
+		assert.Len(t, delta.Credentials, 0)
+	})
+
+	t.Run("ImportOfflineEntries", func(t *testing.T) {
+		device, err := offlineRepo.RegisterOfflineDevice(context.Background(), &booking.RegisterOfflineDeviceRequest{
+			GymId:    gymID,
+			DeviceId: "turnstile-1",
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, device.Key)
+
+		req := &booking.ImportOfflineEntriesRequest{
+			GymId:    gymID,
+			DeviceId: "turnstile-1",
+			Entries: []*booking.OfflineEntry{
+				{
+					ClientEntryId: uuid.New().String(),
+					UserId:        userID,
+					BookingId:     createdBooking.Id,
+					Direction:     "entry",
+					ScannedAt:     time.Now().Add(-30 * time.Minute).Format(time.RFC3339),
+				},
+				{
+					ClientEntryId: uuid.New().String(),
+					UserId:        userID,
+					BookingId:     uuid.New().String(),
+					Direction:     "entry",
+					ScannedAt:     time.Now().Add(-20 * time.Minute).Format(time.RFC3339),
+				},
+			},
+		}
+
+		// Unsigned uploads are rejected
+		_, err = offlineRepo.ImportOfflineEntries(context.Background(), req)
+		assert.ErrorIs(t, err, storage.ErrUnauthenticated)
+
+		signOfflineUpload(t, req, device.Key)
+		resp, err := offlineRepo.ImportOfflineEntries(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), resp.Imported)
+		assert.Equal(t, int32(1), resp.Rejected)
+
+		// Retrying the upload imports nothing twice
+		resp, err = offlineRepo.ImportOfflineEntries(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.Imported)
+		assert.Equal(t, int32(1), resp.Duplicates)
+
+		// A revoked device can no longer upload
+		err = offlineRepo.RevokeOfflineDevice(context.Background(), &booking.RevokeOfflineDeviceRequest{
+			GymId:    gymID,
+			DeviceId: device.DeviceId,
+		})
+		assert.NoError(t, err)
+
+		_, err = offlineRepo.ImportOfflineEntries(context.Background(), req)
+		assert.ErrorIs(t, err, storage.ErrUnauthenticated)
+	})
+
+	t.Run("ExportDelta", func(t *testing.T) {
+		delta, err := offlineRepo.ExportOfflineAccess(context.Background(), &booking.ExportOfflineAccessRequest{
+			GymId:        gymID,
+			SinceVersion: version,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, version+1, delta.Version)
+		assert.Equal(t, version, delta.BaseVersion)
+		if assert.Len(t, delta.Credentials, 1) {
+			assert.Equal(t, int32(2), delta.Credentials[0].VisitsLeft)
+		}
+		assert.Len(t, delta.Removed, 0)
+	})
+}
+
+// signOfflineUpload signs req the way a controller does, with its device key.
+func signOfflineUpload(t *testing.T, req *booking.ImportOfflineEntriesRequest, key string) {
+	req.Signature = ""
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	assert.NoError(t, err)
+	req.Signature = checkin.Sign([]byte(key), payload)
+}
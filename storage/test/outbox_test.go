@@ -0,0 +1,150 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	publisher := events.NewMemoryPublisher()
+	relay := events.NewRelay(postgres.NewOutboxRepo(db, 0, slog.Default()), publisher, time.Second, slog.Default())
+
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "Monthly",
+			Price:    100,
+			Duration: 30,
+			Count:    12,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+		BookingPersonal: &booking.BookingPersonal{
+			UserId:         uuid.New().String(),
+			SubscriptionId: createdSubscription.Id,
+			Payment:        100,
+			AccessStatus:   "granted",
+			StartDate:      time.Now().Format(time.RFC3339),
+			Count:          1,
+		},
+	})
+	assert.NoError(t, err)
+
+	// bookingEvents returns the published events of the test booking
+	bookingEvents := func() []*booking.EventEnvelope {
+		var found []*booking.EventEnvelope
+		for _, event := range publisher.Events() {
+			if event.AggregateType == events.AggregateBooking && event.AggregateId == createdBooking.Id {
+				found = append(found, event)
+			}
+		}
+		return found
+	}
+
+	t.Run("BookingCreatedIsPublished", func(t *testing.T) {
+		_, err := relay.Flush(context.Background())
+		assert.NoError(t, err)
+
+		published := bookingEvents()
+		if assert.Len(t, published, 1) {
+			assert.Equal(t, events.TypeBookingCreated, published[0].Type)
+			assert.Equal(t, int32(1), published[0].Version)
+
+			var created booking.BookingCreatedV1
+			assert.NoError(t, events.Decode(published[0], &created))
+			assert.Equal(t, createdBooking.UserId, created.UserId)
+			assert.Equal(t, gymID, created.GymId)
+			assert.Equal(t, "personal", created.BookingType)
+		}
+	})
+
+	t.Run("PublishedEventsAreNotRepeated", func(t *testing.T) {
+		_, err := relay.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, bookingEvents(), 1)
+	})
+
+	t.Run("BookingCancelledIsPublished", func(t *testing.T) {
+		err := bookingRepo.DeleteBookingPersonal(context.Background(), &booking.DeleteBookingPersonalRequest{Id: createdBooking.Id})
+		assert.NoError(t, err)
+
+		_, err = relay.Flush(context.Background())
+		assert.NoError(t, err)
+
+		published := bookingEvents()
+		if assert.Len(t, published, 2) {
+			assert.Equal(t, events.TypeBookingCancelled, published[1].Type)
+		}
+	})
+
+	t.Run("FailedDeleteHasNoEvent", func(t *testing.T) {
+		// The booking is already gone, so there is nothing to cancel
+		err := bookingRepo.DeleteBookingPersonal(context.Background(), &booking.DeleteBookingPersonalRequest{Id: createdBooking.Id})
+		assert.Error(t, err)
+
+		_, err = relay.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, bookingEvents(), 2)
+	})
+
+	t.Run("EndedBookingExpiresOnce", func(t *testing.T) {
+		endedBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         uuid.New().String(),
+				SubscriptionId: createdSubscription.Id,
+				Payment:        100,
+				StartDate:      time.Now().AddDate(0, 0, -31).Format(time.RFC3339),
+				Count:          12,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteBookingPersonal(t, db, endedBooking.Id)
+
+		expiries := func() []*booking.EventEnvelope {
+			var found []*booking.EventEnvelope
+			for _, event := range publisher.Events() {
+				if event.Type == events.TypeSubscriptionExpired && event.AggregateId == endedBooking.Id {
+					found = append(found, event)
+				}
+			}
+			return found
+		}
+
+		// Sweeping again does not expire the booking twice
+		for i := 0; i < 2; i++ {
+			_, err = relay.Sweep(context.Background())
+			assert.NoError(t, err)
+			_, err = relay.Flush(context.Background())
+			assert.NoError(t, err)
+		}
+
+		published := expiries()
+		if assert.Len(t, published, 1) {
+			var expired booking.SubscriptionExpiredV1
+			assert.NoError(t, events.Decode(published[0], &expired))
+			assert.Equal(t, "period_ended", expired.Reason)
+			assert.Equal(t, endedBooking.UserId, expired.UserId)
+			assert.Equal(t, gymID, expired.GymId)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/pubsub"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	passRepo := postgres.NewPassRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	t.Run("IssueTrialPassOncePerGym", func(t *testing.T) {
+		userID := uuid.New().String()
+
+		req := &booking.IssuePassRequest{
+			Pass: &booking.Pass{
+				GymId:  gymID,
+				UserId: userID,
+				Type:   "trial",
+				Visits: 2,
+			},
+		}
+
+		createdPass, err := passRepo.IssuePass(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotNil(t, createdPass)
+		assert.NotEmpty(t, createdPass.Id)
+		assert.Equal(t, userID, createdPass.UserId)
+		assert.Equal(t, int32(2), createdPass.Visits)
+
+		// A second trial at the same gym is rejected
+		_, err = passRepo.IssuePass(context.Background(), &booking.IssuePassRequest{
+			Pass: &booking.Pass{
+				GymId:  gymID,
+				UserId: userID,
+				Type:   "trial",
+				Visits: 1,
+			},
+		})
+		assert.Error(t, err)
+
+		// Cleanup
+		defer deletePass(t, db, createdPass.Id)
+	})
+
+	t.Run("IssueGuestPass", func(t *testing.T) {
+		req := &booking.IssuePassRequest{
+			Pass: &booking.Pass{
+				GymId:       gymID,
+				PhoneNumber: "+998901234567",
+				Type:        "guest",
+				Price:       50,
+				ValidFrom:   time.Now().Format(time.RFC3339),
+			},
+		}
+
+		createdPass, err := passRepo.IssuePass(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotNil(t, createdPass)
+		assert.Equal(t, req.Pass.PhoneNumber, createdPass.PhoneNumber)
+		assert.Empty(t, createdPass.UserId)
+
+		listResponse, err := passRepo.ListPasses(context.Background(), &booking.ListPassesRequest{
+			GymId:       gymID,
+			PhoneNumber: req.Pass.PhoneNumber,
+		})
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, len(listResponse.Passes), 1)
+
+		// Cleanup
+		defer deletePass(t, db, createdPass.Id)
+	})
+
+	t.Run("RevokePass", func(t *testing.T) {
+		createdPass, err := passRepo.IssuePass(context.Background(), &booking.IssuePassRequest{
+			Pass: &booking.Pass{
+				GymId:  gymID,
+				UserId: uuid.New().String(),
+				Type:   "guest",
+			},
+		})
+		assert.NoError(t, err)
+
+		err = passRepo.RevokePass(context.Background(), &booking.RevokePassRequest{Id: createdPass.Id})
+		assert.NoError(t, err)
+
+		revokedPass, err := passRepo.GetPass(context.Background(), &booking.GetPassRequest{Id: createdPass.Id})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, revokedPass.RevokedAt)
+
+		// Revoking twice reports no rows
+		err = passRepo.RevokePass(context.Background(), &booking.RevokePassRequest{Id: createdPass.Id})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		// Cleanup
+		defer deletePass(t, db, createdPass.Id)
+	})
+
+	t.Run("PassAfterDeniedBooking", func(t *testing.T) {
+		subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+		bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+		accessBetaRepo := postgres.NewAccessBetaRepo(db, config.Config{AccessReentryTimeout: time.Hour},
+			pubsub.NewBroker[*booking.Occupancy](16), pubsub.NewBroker[*booking.AccessEvent](16), slog.Default())
+
+		// A booking that only allows a day other than today (the test gym
+		// runs on UTC)
+		createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+			SubscriptionPersonal: &booking.SubscriptionPersonal{
+				GymId:       gymID,
+				Type:        "Weekend",
+				Price:       100,
+				Duration:    30,
+				Count:       10,
+				TimeWindows: []*booking.TimeWindow{{Weekday: int32(time.Now().UTC().Weekday()+3) % 7, StartTime: "00:00", EndTime: "23:59"}},
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+		userID := uuid.New().String()
+		createdBooking, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         userID,
+				SubscriptionId: createdSubscription.Id,
+				Payment:        100,
+				StartDate:      time.Now().Add(-time.Minute).Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteBookingPersonal(t, db, createdBooking.Id)
+
+		req := &booking.AccessBetaPersonalRequest{UserId: userID, SportHallId: gymID}
+		resp, err := accessBetaRepo.CheckUserAccess(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "denied", resp.Message)
+		assert.Equal(t, "outside allowed hours", resp.Reason)
+
+		// A guest pass still lets the member in when the booking does not
+		createdPass, err := passRepo.IssuePass(context.Background(), &booking.IssuePassRequest{
+			Pass: &booking.Pass{
+				GymId:     gymID,
+				UserId:    userID,
+				Type:      "guest",
+				ValidFrom: time.Now().Add(-time.Minute).Format(time.RFC3339),
+			},
+		})
+		assert.NoError(t, err)
+		defer deletePass(t, db, createdPass.Id)
+
+		resp, err = accessBetaRepo.CheckUserAccess(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, "granted", resp.Message)
+	})
+
+	t.Run("GetTrialConversionReport", func(t *testing.T) {
+		report, err := passRepo.GetTrialConversionReport(context.Background(), &booking.TrialConversionReportRequest{
+			GymId: gymID,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, report)
+		assert.GreaterOrEqual(t, report.TrialsIssued, int32(1))
+		assert.LessOrEqual(t, report.Converted, report.TrialsIssued)
+	})
+}
+
+func deletePass(t *testing.T, db *pgxpool.Pool, id string) {
+	// _, err := db.Exec(context.Background(), "DELETE FROM passes WHERE id = $1", id)
+}
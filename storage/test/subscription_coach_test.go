@@ -2,20 +2,22 @@ package test
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestSubscriptionCoachRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	subscriptionRepo := postgres.NewSubscriptionCoachRepo(db)
+	subscriptionRepo := postgres.NewSubscriptionCoachRepo(db, slog.Default())
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -194,7 +196,7 @@ func TestSubscriptionCoachRepo(t *testing.T) {
 	})
 }
 
-func deleteSubscriptionCoach(t *testing.T, db *pgx.Conn, id string) {
+func deleteSubscriptionCoach(t *testing.T, db *pgxpool.Pool, id string) {
 	// _, err := db.Exec(context.Background(), "DELETE FROM subscription_coach WHERE id = $1", id)
 	// assert.NoError(t, err)
 }
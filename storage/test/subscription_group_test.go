@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -9,14 +10,15 @@ import (
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestSubscriptionGroupRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	subscriptionRepo := postgres.NewSubscriptionGroupRepo(db)
+	subscriptionRepo := postgres.NewSubscriptionGroupRepo(db, slog.Default())
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -219,7 +221,7 @@ func TestSubscriptionGroupRepo(t *testing.T) {
 	})
 }
 
-func deleteSubscriptionGroup(t *testing.T, db *pgx.Conn, id string) {
+func deleteSubscriptionGroup(t *testing.T, db *pgxpool.Pool, id string) {
 	// _, err := db.Exec(context.Background(), "DELETE FROM subscription_group WHERE id = $1", id)
 	// assert.NoError(t, err)
 }
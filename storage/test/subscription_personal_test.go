@@ -3,17 +3,19 @@ package test
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"testing"
 
 	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
 	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func createDBConnection(t *testing.T) *pgx.Conn {
+func createDBConnection(t *testing.T) *pgxpool.Pool {
 	dbCon := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s",
 		"sayyidmuhammad", // Replace with your DB user
 		"root",           // Replace with your DB password
@@ -22,14 +24,14 @@ func createDBConnection(t *testing.T) *pgx.Conn {
 		"postgres",       // Replace with your DB name
 	)
 
-	db, err := pgx.Connect(context.Background(), dbCon)
+	db, err := pgxpool.New(context.Background(), dbCon)
 	if err != nil {
 		t.Fatalf("Unable to connect to database: %v", err)
 	}
 	return db
 }
 
-func createGym(t *testing.T, db *pgx.Conn) string {
+func createGym(t *testing.T, db *pgxpool.Pool) string {
 	gymID := uuid.New().String()
 	query := `
 		INSERT INTO sport_halls (
@@ -60,15 +62,15 @@ func createGym(t *testing.T, db *pgx.Conn) string {
 	return gymID
 }
 
-func deleteGym(t *testing.T, db *pgx.Conn, gymID string) {
+func deleteGym(t *testing.T, db *pgxpool.Pool, gymID string) {
 
 }
 
 func TestSubscriptionPersonalRepo(t *testing.T) {
 	db := createDBConnection(t)
-	defer db.Close(context.Background())
+	defer db.Close()
 
-	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db)
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
 
 	gymID := createGym(t, db)
 	defer deleteGym(t, db, gymID)
@@ -167,6 +169,98 @@ func TestSubscriptionPersonalRepo(t *testing.T) {
 		defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
 	})
 
+	t.Run("ListSubscriptionPersonalVersions", func(t *testing.T) {
+		createReq := &booking.CreateSubscriptionPersonalRequest{
+			SubscriptionPersonal: &booking.SubscriptionPersonal{
+				GymId:       gymID,
+				Type:        "Personal Training",
+				Description: "One-on-one training sessions",
+				Price:       100,
+				Duration:    60, // In minutes
+				Count:       10,
+			},
+		}
+		createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), createReq)
+		assert.NoError(t, err)
+		assert.NotNil(t, createdSubscription)
+		assert.Equal(t, int32(1), createdSubscription.Version)
+
+		// A description-only change keeps the current version
+		createdSubscription.Description = "Updated description"
+		updatedSubscription, err := subscriptionRepo.UpdateSubscriptionPersonal(context.Background(), &booking.UpdateSubscriptionPersonalRequest{
+			SubscriptionPersonal: createdSubscription,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), updatedSubscription.Version)
+
+		// A price change creates a new version
+		updatedSubscription.Price = 150
+		updatedSubscription, err = subscriptionRepo.UpdateSubscriptionPersonal(context.Background(), &booking.UpdateSubscriptionPersonalRequest{
+			SubscriptionPersonal: updatedSubscription,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), updatedSubscription.Version)
+
+		versions, err := subscriptionRepo.ListSubscriptionPersonalVersions(context.Background(), &booking.ListSubscriptionVersionsRequest{
+			SubscriptionId: createdSubscription.Id,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, versions.Versions, 2)
+		assert.Equal(t, int32(100), versions.Versions[0].Price)
+		assert.Equal(t, int32(150), versions.Versions[1].Price)
+
+		// Cleanup
+		defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+	})
+
+	t.Run("TimeWindowsFollowVersions", func(t *testing.T) {
+		createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+			SubscriptionPersonal: &booking.SubscriptionPersonal{
+				GymId:       gymID,
+				Type:        "Personal Training",
+				Description: "Mornings only",
+				Price:       100,
+				Duration:    60,
+				Count:       10,
+				TimeWindows: []*booking.TimeWindow{{Weekday: 1, StartTime: "06:00", EndTime: "12:00"}},
+			},
+		})
+		assert.NoError(t, err)
+		defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+		// Leaving the windows out keeps them
+		createdSubscription.Description = "Updated description"
+		createdSubscription.TimeWindows = nil
+		updatedSubscription, err := subscriptionRepo.UpdateSubscriptionPersonal(context.Background(), &booking.UpdateSubscriptionPersonalRequest{
+			SubscriptionPersonal: createdSubscription,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), updatedSubscription.Version)
+		assert.Len(t, updatedSubscription.TimeWindows, 1)
+
+		// Replacing them creates a new version and keeps the old one's windows
+		updatedSubscription.TimeWindows = []*booking.TimeWindow{{Weekday: 1, StartTime: "06:00", EndTime: "10:00"}}
+		updatedSubscription, err = subscriptionRepo.UpdateSubscriptionPersonal(context.Background(), &booking.UpdateSubscriptionPersonalRequest{
+			SubscriptionPersonal: updatedSubscription,
+			SetTimeWindows:       true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), updatedSubscription.Version)
+
+		retrievedSubscription, err := subscriptionRepo.GetSubscriptionPersonal(context.Background(), &booking.GetSubscriptionPersonalRequest{Id: createdSubscription.Id})
+		assert.NoError(t, err)
+		if assert.Len(t, retrievedSubscription.TimeWindows, 1) {
+			assert.Equal(t, "10:00", retrievedSubscription.TimeWindows[0].EndTime)
+		}
+
+		var oldWindows int
+		err = db.QueryRow(context.Background(), `
+			SELECT COUNT(*) FROM subscription_time_windows WHERE subscription_id = $1 AND version = 1
+		`, createdSubscription.Id).Scan(&oldWindows)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, oldWindows)
+	})
+
 	t.Run("DeleteSubscriptionPersonal", func(t *testing.T) {
 		createReq := &booking.CreateSubscriptionPersonalRequest{
 			SubscriptionPersonal: &booking.SubscriptionPersonal{
@@ -242,6 +336,6 @@ func TestSubscriptionPersonalRepo(t *testing.T) {
 	})
 }
 
-func deleteSubscriptionPersonal(t *testing.T, db *pgx.Conn, id string) {
+func deleteSubscriptionPersonal(t *testing.T, db *pgxpool.Pool, id string) {
 	// _, err := db.Exec(context.Background(), "DELETE FROM subscription_personal WHERE id = $1", id)	assert.NoError(t, err)
 }
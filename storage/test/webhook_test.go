@@ -0,0 +1,192 @@
+package test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/config"
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/Athlevo/Booking-Athlevo/storage/postgres"
+	"github.com/Athlevo/Booking-Athlevo/webhook"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRepo(t *testing.T) {
+	db := createDBConnection(t)
+	defer db.Close()
+
+	// The test endpoint is a plain http server on loopback
+	webhookRepo := postgres.NewWebhookRepo(db, config.Config{WebhookAllowPrivate: true}, slog.Default())
+	bookingRepo := postgres.NewBookingPersonalRepo(db, slog.Default())
+	subscriptionRepo := postgres.NewSubscriptionPersonalRepo(db, slog.Default())
+
+	gymID := createGym(t, db)
+	defer deleteGym(t, db, gymID)
+
+	// The endpoint accepts verified requests until failing is set
+	var (
+		secret   string
+		failing  atomic.Bool
+		received atomic.Int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if failing.Load() || webhook.Verify(secret, r.Header.Get(webhook.HeaderSignature), body, time.Minute, time.Now()) != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received.Add(1)
+	}))
+	defer server.Close()
+
+	// A single attempt makes failed deliveries dead right away
+	dispatcher := webhook.NewDispatcher(webhookRepo, server.Client(), 1, time.Second, slog.Default())
+
+	var endpoint *booking.WebhookEndpoint
+
+	t.Run("CreateWebhookEndpoint", func(t *testing.T) {
+		_, err := webhookRepo.CreateWebhookEndpoint(context.Background(), &booking.CreateWebhookEndpointRequest{
+			WebhookEndpoint: &booking.WebhookEndpoint{GymId: gymID, Url: server.URL, EventTypes: []string{"booking.renamed"}},
+		})
+		assert.ErrorContains(t, err, "unknown event type")
+
+		// By default endpoints must be https urls on public hosts
+		strictRepo := postgres.NewWebhookRepo(db, config.Config{}, slog.Default())
+		for _, url := range []string{server.URL, "https://127.0.0.1/hook", "https://169.254.169.254/latest/meta-data"} {
+			_, err = strictRepo.CreateWebhookEndpoint(context.Background(), &booking.CreateWebhookEndpointRequest{
+				WebhookEndpoint: &booking.WebhookEndpoint{GymId: gymID, Url: url},
+			})
+			assert.Error(t, err, url)
+		}
+
+		endpoint, err = webhookRepo.CreateWebhookEndpoint(context.Background(), &booking.CreateWebhookEndpointRequest{
+			WebhookEndpoint: &booking.WebhookEndpoint{
+				GymId:      gymID,
+				Url:        server.URL,
+				EventTypes: []string{events.TypeBookingCreated},
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, endpoint.Secret)
+		assert.True(t, endpoint.Active)
+		secret = endpoint.Secret
+
+		listed, err := webhookRepo.ListWebhookEndpoints(context.Background(), &booking.ListWebhookEndpointsRequest{GymId: gymID})
+		assert.NoError(t, err)
+		if assert.Len(t, listed.WebhookEndpoints, 1) {
+			assert.Empty(t, listed.WebhookEndpoints[0].Secret)
+		}
+
+		// The change is audited without the signing secret
+		history, err := postgres.NewAuditRepo(db, slog.Default()).GetEntityHistory(context.Background(), &booking.GetEntityHistoryRequest{
+			EntityType: "webhook_endpoints",
+			EntityId:   endpoint.Id,
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, history.Entries, 1) {
+			assert.Equal(t, "create", history.Entries[0].Action)
+			assert.NotContains(t, history.Entries[0].After, secret)
+		}
+	})
+
+	createdSubscription, err := subscriptionRepo.CreateSubscriptionPersonal(context.Background(), &booking.CreateSubscriptionPersonalRequest{
+		SubscriptionPersonal: &booking.SubscriptionPersonal{
+			GymId:    gymID,
+			Type:     "Monthly",
+			Price:    100,
+			Duration: 30,
+			Count:    12,
+		},
+	})
+	assert.NoError(t, err)
+	defer deleteSubscriptionPersonal(t, db, createdSubscription.Id)
+
+	createBooking := func() *booking.BookingPersonal {
+		created, err := bookingRepo.CreateBookingPersonal(context.Background(), &booking.CreateBookingPersonalRequest{
+			BookingPersonal: &booking.BookingPersonal{
+				UserId:         uuid.New().String(),
+				SubscriptionId: createdSubscription.Id,
+				Payment:        100,
+				StartDate:      time.Now().Format(time.RFC3339),
+				Count:          1,
+			},
+		})
+		assert.NoError(t, err)
+		return created
+	}
+
+	t.Run("DeliverSignedEvent", func(t *testing.T) {
+		created := createBooking()
+		defer deleteBookingPersonal(t, db, created.Id)
+
+		n, err := dispatcher.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, int32(1), received.Load())
+
+		deliveries, err := webhookRepo.ListWebhookDeliveries(context.Background(), &booking.ListWebhookDeliveriesRequest{EndpointId: endpoint.Id})
+		assert.NoError(t, err)
+		if assert.Len(t, deliveries.Deliveries, 1) {
+			assert.Equal(t, "delivered", deliveries.Deliveries[0].Status)
+			assert.Equal(t, int32(http.StatusOK), deliveries.Deliveries[0].LastStatusCode)
+		}
+	})
+
+	t.Run("FailedDeliveryIsDeadLetteredAndReplayed", func(t *testing.T) {
+		failing.Store(true)
+		created := createBooking()
+		defer deleteBookingPersonal(t, db, created.Id)
+
+		n, err := dispatcher.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		dead, err := webhookRepo.ListWebhookDeliveries(context.Background(), &booking.ListWebhookDeliveriesRequest{
+			GymId:  gymID,
+			Status: "dead",
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, dead.Deliveries, 1) {
+			assert.Equal(t, int32(http.StatusServiceUnavailable), dead.Deliveries[0].LastStatusCode)
+		}
+
+		failing.Store(false)
+		replayed, err := webhookRepo.ReplayWebhookDeliveries(context.Background(), &booking.ReplayWebhookDeliveriesRequest{EndpointId: endpoint.Id})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), replayed.Replayed)
+
+		n, err = dispatcher.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, int32(2), received.Load())
+	})
+
+	t.Run("InactiveEndpointIsSkipped", func(t *testing.T) {
+		endpoint.Active = false
+		_, err := webhookRepo.UpdateWebhookEndpoint(context.Background(), &booking.UpdateWebhookEndpointRequest{WebhookEndpoint: endpoint})
+		assert.NoError(t, err)
+
+		created := createBooking()
+		defer deleteBookingPersonal(t, db, created.Id)
+
+		n, err := dispatcher.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("DeleteWebhookEndpoint", func(t *testing.T) {
+		err := webhookRepo.DeleteWebhookEndpoint(context.Background(), &booking.DeleteWebhookEndpointRequest{Id: endpoint.Id})
+		assert.NoError(t, err)
+
+		err = webhookRepo.DeleteWebhookEndpoint(context.Background(), &booking.DeleteWebhookEndpointRequest{Id: endpoint.Id})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DBTracer starts a span for every SQL statement run on a pgx connection it
+// is set as the tracer of. Arguments are left out since they carry member
+// data.
+type DBTracer struct{}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (DBTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	statement := strings.Join(strings.Fields(data.SQL), " ")
+	ctx, _ = Start(ctx, "postgres "+operation(statement),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.name", conn.Config().Database),
+			attribute.String("db.statement", statement),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (DBTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil && data.Err != pgx.ErrNoRows {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+// operation returns the leading keyword of statement, such as SELECT or
+// INSERT, to name its span.
+func operation(statement string) string {
+	keyword, _, _ := strings.Cut(statement, " ")
+	if keyword == "" {
+		return "query"
+	}
+	return strings.ToUpper(keyword)
+}
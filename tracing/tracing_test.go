@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStart(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previous)
+
+	// Without a parent nothing is recorded
+	ctx, span := Start(context.Background(), "OutboxRepo.DeliverPending")
+	assert.False(t, span.IsRecording())
+	span.End()
+	assert.Equal(t, context.Background(), ctx)
+	assert.Empty(t, exporter.GetSpans())
+
+	// Under a request span the repo span is its child
+	ctx, root := otel.Tracer("test").Start(context.Background(), "gym.BookingPersonalService/CreateBookingPersonal")
+	_, child := Start(ctx, "BookingPersonalRepo.CreateBookingPersonal")
+	assert.True(t, child.IsRecording())
+	child.End()
+	root.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "BookingPersonalRepo.CreateBookingPersonal", spans[0].Name)
+	assert.Equal(t, root.SpanContext().SpanID(), spans[0].Parent.SpanID())
+}
+
+func TestOperation(t *testing.T) {
+	assert.Equal(t, "SELECT", operation("select count(*) from booking_group"))
+	assert.Equal(t, "INSERT", operation("INSERT INTO outbox (id) VALUES ($1)"))
+	assert.Equal(t, "query", operation(""))
+}
+
+func TestSetupUnknownExporter(t *testing.T) {
+	_, err := Setup(context.Background(), Options{Exporter: "jaeger"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrPrivateAddress is returned for endpoints on loopback, link-local or
+// private addresses, which would let a gym owner reach our own network.
+var ErrPrivateAddress = errors.New("webhook endpoint must be a public host")
+
+// CheckURL checks that raw is a url deliveries may be sent to: https on a
+// public host. allowPrivate also accepts http and non-public hosts, for tests
+// and local development.
+func CheckURL(raw string, allowPrivate bool) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("webhook url must be an absolute url")
+	}
+	if u.Scheme != "https" && !(allowPrivate && u.Scheme == "http") {
+		return fmt.Errorf("webhook url must use https")
+	}
+	if allowPrivate {
+		return nil
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrPrivateAddress
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !isPublic(ip) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// NewClient returns the HTTP client deliveries are sent with. Unless
+// allowPrivate is set it refuses to connect to non-public addresses, which
+// also covers public host names that resolve to one.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   denyPrivate,
+		}
+		transport.Proxy = nil
+		transport.DialContext = dialer.DialContext
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// denyPrivate refuses connections to non-public addresses once the host name
+// has been resolved.
+func denyPrivate(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !isPublic(ip) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// isPublic reports whether ip is a globally routable unicast address.
+func isPublic(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is not
+// reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		url          string
+		allowPrivate bool
+		wantErr      bool
+	}{
+		{url: "https://hooks.example.com/athlevo", wantErr: false},
+		{url: "https://93.184.215.14/hook", wantErr: false},
+		{url: "http://hooks.example.com/athlevo", wantErr: true},
+		{url: "ftp://hooks.example.com", wantErr: true},
+		{url: "/relative", wantErr: true},
+		{url: "https://localhost/hook", wantErr: true},
+		{url: "https://127.0.0.1:8443/hook", wantErr: true},
+		{url: "https://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "https://10.1.2.3/hook", wantErr: true},
+		{url: "https://192.168.0.10/hook", wantErr: true},
+		{url: "https://100.64.0.1/hook", wantErr: true},
+		{url: "https://[::1]/hook", wantErr: true},
+		{url: "https://[fd00::1]/hook", wantErr: true},
+		{url: "https://[::ffff:127.0.0.1]/hook", wantErr: true},
+		{url: "http://127.0.0.1:8080/hook", allowPrivate: true, wantErr: false},
+		{url: "ftp://127.0.0.1/hook", allowPrivate: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := CheckURL(tt.url, tt.allowPrivate)
+		if tt.wantErr {
+			assert.Error(t, err, tt.url)
+		} else {
+			assert.NoError(t, err, tt.url)
+		}
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// Connections to private addresses are refused when dialling, whatever
+	// the url looked like when the endpoint was registered
+	_, err := NewClient(time.Second, false).Get(server.URL)
+	assert.ErrorIs(t, err, ErrPrivateAddress)
+
+	resp, err := NewClient(time.Second, true).Get(server.URL)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,168 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// dispatchBatchSize is how many deliveries are claimed at a time.
+const dispatchBatchSize = 20
+
+// maxRetryDelay caps the wait between attempts.
+const maxRetryDelay = 6 * time.Hour
+
+// Delivery is one event due to be sent to one endpoint.
+type Delivery struct {
+	ID        string
+	URL       string
+	Secret    string
+	EventType string
+	Body      []byte
+	Attempts  int32 // attempts made before this one
+}
+
+// Attempt is the outcome of sending a delivery.
+type Attempt struct {
+	StatusCode    int    // zero when no response was received
+	Error         string // empty when the endpoint accepted the delivery
+	NextAttemptAt time.Time
+	Dead          bool // no retries are left
+}
+
+// Store holds the deliveries waiting to be sent.
+type Store interface {
+	// ClaimDeliveries returns up to limit deliveries that are due and hides
+	// them from other claims for lease, long enough to send them.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+	// RecordAttempt stores the outcome of sending a delivery.
+	RecordAttempt(ctx context.Context, id string, attempt Attempt) error
+}
+
+// Dispatcher sends due deliveries. A delivery succeeds when the endpoint
+// answers with a 2xx status; otherwise it is retried with exponential
+// backoff until maxAttempts attempts have failed, when it becomes dead.
+type Dispatcher struct {
+	store       Store
+	client      *http.Client
+	maxAttempts int32
+	retryBase   time.Duration
+	logger      *slog.Logger
+}
+
+// NewDispatcher creates a Dispatcher. The first retry waits retryBase and
+// each later one twice as long as the one before.
+func NewDispatcher(store Store, client *http.Client, maxAttempts int32, retryBase time.Duration, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		store:       store,
+		client:      client,
+		maxAttempts: maxAttempts,
+		retryBase:   retryBase,
+		logger:      logger,
+	}
+}
+
+// Run sends deliveries every interval until ctx is done. A flush under way
+// when ctx is done is finished before Run returns.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	work := context.WithoutCancel(ctx)
+	for {
+		if _, err := d.Flush(work); err != nil {
+			d.logger.ErrorContext(ctx, "error dispatching webhooks", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush sends every delivery that is due now and returns how many were
+// accepted.
+func (d *Dispatcher) Flush(ctx context.Context) (int, error) {
+	// Claims outlive the slowest possible request so no delivery is sent twice at once
+	lease := d.client.Timeout + time.Minute
+
+	delivered := 0
+	for {
+		batch, err := d.store.ClaimDeliveries(ctx, dispatchBatchSize, lease)
+		if err != nil {
+			return delivered, err
+		}
+
+		for _, delivery := range batch {
+			attempt := d.send(ctx, delivery)
+			if attempt.Error == "" {
+				delivered++
+			}
+			if err := d.store.RecordAttempt(ctx, delivery.ID, attempt); err != nil {
+				return delivered, err
+			}
+		}
+
+		if len(batch) < dispatchBatchSize {
+			return delivered, nil
+		}
+	}
+}
+
+// send POSTs a delivery and decides when, if ever, to retry it.
+func (d *Dispatcher) send(ctx context.Context, delivery *Delivery) Attempt {
+	var attempt Attempt
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		attempt.Error = err.Error()
+	} else {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(HeaderEvent, delivery.EventType)
+		req.Header.Set(HeaderDelivery, delivery.ID)
+		req.Header.Set(HeaderSignature, Sign(delivery.Secret, time.Now(), delivery.Body))
+
+		resp, err := d.client.Do(req)
+		if err != nil {
+			attempt.Error = err.Error()
+		} else {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+
+			attempt.StatusCode = resp.StatusCode
+			if resp.StatusCode < 200 || resp.StatusCode > 299 {
+				attempt.Error = fmt.Sprintf("endpoint answered %s", resp.Status)
+			}
+		}
+	}
+
+	if attempt.Error == "" {
+		return attempt
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= d.maxAttempts {
+		attempt.Dead = true
+		return attempt
+	}
+	attempt.NextAttemptAt = time.Now().Add(d.retryDelay(attempts))
+	return attempt
+}
+
+// retryDelay is the wait after the given number of failed attempts.
+func (d *Dispatcher) retryDelay(attempts int32) time.Duration {
+	delay := d.retryBase
+	for i := int32(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryStore keeps deliveries in memory and every attempt made.
+type memoryStore struct {
+	mu       sync.Mutex
+	due      []*Delivery
+	attempts map[string][]Attempt
+}
+
+func (s *memoryStore) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := min(limit, len(s.due))
+	claimed := s.due[:n]
+	s.due = s.due[n:]
+	return claimed, nil
+}
+
+func (s *memoryStore) RecordAttempt(ctx context.Context, id string, attempt Attempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.attempts == nil {
+		s.attempts = make(map[string][]Attempt)
+	}
+	s.attempts[id] = append(s.attempts[id], attempt)
+	return nil
+}
+
+func TestDispatcher(t *testing.T) {
+	const secret = "whsec_test"
+
+	envelope, err := events.New(events.TypeBookingCreated, 1, events.AggregateBooking, "booking-1", &booking.BookingCreatedV1{
+		BookingId:   "booking-1",
+		BookingType: "personal",
+	})
+	assert.NoError(t, err)
+	envelope.GymId = "gym-1"
+
+	payload, err := Body(envelope)
+	assert.NoError(t, err)
+
+	t.Run("SignedDeliveryIsAccepted", func(t *testing.T) {
+		var received struct {
+			Type  string `json:"type"`
+			GymID string `json:"gym_id"`
+			Data  struct {
+				BookingID string `json:"booking_id"`
+			} `json:"data"`
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if err := Verify(secret, r.Header.Get(HeaderSignature), body, time.Minute, time.Now()); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, events.TypeBookingCreated, r.Header.Get(HeaderEvent))
+			assert.NoError(t, json.Unmarshal(body, &received))
+		}))
+		defer server.Close()
+
+		store := &memoryStore{due: []*Delivery{{ID: "d1", URL: server.URL, Secret: secret, EventType: envelope.Type, Body: payload}}}
+		dispatcher := NewDispatcher(store, server.Client(), 3, time.Second, slog.Default())
+
+		n, err := dispatcher.Flush(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		if assert.Len(t, store.attempts["d1"], 1) {
+			assert.Empty(t, store.attempts["d1"][0].Error)
+			assert.Equal(t, http.StatusOK, store.attempts["d1"][0].StatusCode)
+		}
+		assert.Equal(t, events.TypeBookingCreated, received.Type)
+		assert.Equal(t, "gym-1", received.GymID)
+		assert.Equal(t, "booking-1", received.Data.BookingID)
+	})
+
+	t.Run("FailedDeliveryBacksOffThenDies", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		store := &memoryStore{}
+		dispatcher := NewDispatcher(store, server.Client(), 3, time.Second, slog.Default())
+
+		for attempts := int32(0); attempts < 3; attempts++ {
+			store.due = []*Delivery{{ID: "d1", URL: server.URL, Secret: secret, Body: payload, Attempts: attempts}}
+			n, err := dispatcher.Flush(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 0, n)
+		}
+
+		attempts := store.attempts["d1"]
+		if assert.Len(t, attempts, 3) {
+			assert.Equal(t, http.StatusInternalServerError, attempts[0].StatusCode)
+			assert.False(t, attempts[0].Dead)
+			assert.WithinDuration(t, time.Now().Add(time.Second), attempts[0].NextAttemptAt, time.Second)
+			assert.WithinDuration(t, time.Now().Add(2*time.Second), attempts[1].NextAttemptAt, time.Second)
+			assert.True(t, attempts[2].Dead)
+		}
+	})
+
+	t.Run("VerifyRejectsTampering", func(t *testing.T) {
+		now := time.Now()
+		header := Sign(secret, now, payload)
+
+		assert.NoError(t, Verify(secret, header, payload, time.Minute, now))
+		assert.ErrorIs(t, Verify("other", header, payload, time.Minute, now), ErrSignature)
+		assert.ErrorIs(t, Verify(secret, header, append(payload, ' '), time.Minute, now), ErrSignature)
+		assert.ErrorIs(t, Verify(secret, header, payload, time.Minute, now.Add(time.Hour)), ErrExpired)
+		assert.ErrorIs(t, Verify(secret, "v1=abc", payload, time.Minute, now), ErrMalformed)
+	})
+}
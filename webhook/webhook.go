@@ -0,0 +1,111 @@
+// Package webhook sends a gym's domain events to the HTTP endpoints its owner
+// registered, signed so the receiver can check they came from us.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Athlevo/Booking-Athlevo/events"
+	"github.com/Athlevo/Booking-Athlevo/genproto/booking"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Request headers sent with every delivery.
+const (
+	HeaderEvent     = "X-Athlevo-Event"
+	HeaderDelivery  = "X-Athlevo-Delivery"
+	HeaderSignature = "X-Athlevo-Signature"
+)
+
+// Errors returned by Verify.
+var (
+	ErrMalformed = errors.New("malformed webhook signature")
+	ErrSignature = errors.New("webhook signature mismatch")
+	ErrExpired   = errors.New("webhook signature too old")
+)
+
+// body is the JSON document POSTed for an event.
+type body struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int32           `json:"version"`
+	GymID      string          `json:"gym_id"`
+	OccurredAt string          `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Body renders the request body for an event, with its payload as JSON.
+func Body(envelope *booking.EventEnvelope) ([]byte, error) {
+	payload, err := events.Payload(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s event: %w", envelope.Type, err)
+	}
+
+	return json.Marshal(body{
+		ID:         envelope.Id,
+		Type:       envelope.Type,
+		Version:    envelope.Version,
+		GymID:      envelope.GymId,
+		OccurredAt: envelope.OccurredAt,
+		Data:       data,
+	})
+}
+
+// Sign returns the signature header for a body sent at t: the timestamp and
+// the hex HMAC-SHA256 of "<timestamp>.<body>" under secret.
+func Sign(secret string, t time.Time, payload []byte) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + signature(secret, timestamp, payload)
+}
+
+// Verify checks a signature header against the body received, rejecting
+// signatures older than tolerance so captured requests cannot be replayed.
+func Verify(secret, header string, payload []byte, tolerance time.Duration, now time.Time) error {
+	var timestamp, sig string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			sig = value
+		}
+	}
+	if timestamp == "" || sig == "" {
+		return ErrMalformed
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrMalformed
+	}
+	if !hmac.Equal([]byte(sig), []byte(signature(secret, timestamp, payload))) {
+		return ErrSignature
+	}
+	if now.Sub(time.Unix(unix, 0)) > tolerance {
+		return ErrExpired
+	}
+
+	return nil
+}
+
+func signature(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}